@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"github.com/djyuhn/gitcha/cmd/gitcha"
+	"github.com/djyuhn/gitcha/internal/reporeader"
+
+	"github.com/spf13/pflag"
+)
+
+// analysisFlags are the flags configuring the repository analysis. The root command registers them as persistent
+// flags so that every subcommand analyzes the repository the same way.
+type analysisFlags struct {
+	similarity        uint
+	detectCopies      bool
+	credit            string
+	coAuthorTrailers  []string
+	bots              string
+	botPatterns       []string
+	staleAfter        string
+	issuePatterns     []string
+	keyringPaths      []string
+	protectedBranches []string
+	largeBlobCount    int
+	analyses          []string
+}
+
+// register adds the analysis flags to flags.
+func (f *analysisFlags) register(flags *pflag.FlagSet) {
+	flags.UintVar(&f.similarity, "similarity", reporeader.DefaultSimilarity,
+		"similarity percentage for a changed file to be followed as a rename or copy (100 for exact matches, 0 to disable)")
+
+	flags.BoolVar(&f.detectCopies, "detect-copies", true,
+		"follow files copied from files modified in the same commit")
+
+	flags.StringVar(&f.credit, "credit", string(reporeader.CreditPrimary),
+		"credit commits to their author alone (primary) or to their co-authors as well as their author (shared)")
+
+	flags.StringSliceVar(&f.coAuthorTrailers, "co-author-trailer", reporeader.DefaultCoAuthorTrailers,
+		"commit message trailers listing co-authors as \"Name <email>\"")
+
+	flags.StringVar(&f.bots, "bots", string(reporeader.BotsInclude),
+		"analyze bot and automation accounts like any other author (include), leave them out (exclude) or list them apart (separate)")
+
+	flags.StringSliceVar(&f.botPatterns, "bot", nil,
+		"additional names or emails of bot accounts, wildcards allowed (e.g. ci-*@example.com)")
+
+	flags.StringVar(&f.staleAfter, "stale-after", "90d",
+		"time without commits, relative to the latest commit on any branch, after which a branch is stale (e.g. 90d, 6w, 1y, 0 to disable)")
+
+	flags.StringArrayVar(&f.issuePatterns, "issue-pattern", reporeader.DefaultIssuePatterns,
+		"regular expression matching an issue reference, using the first capture group as the issue when there is one (repeatable, replaces the default; required for tracker keys such as JIRA-456, e.g. 'JIRA-\\d+')")
+
+	flags.StringArrayVar(&f.keyringPaths, "keyring", nil,
+		"armored OpenPGP public keyring or SSH allowed signers file to verify commit signatures against (repeatable)")
+
+	flags.StringSliceVar(&f.protectedBranches, "protected-branch", nil,
+		"branches whose unsigned commits are reported (default: the default branch)")
+
+	flags.IntVar(&f.largeBlobCount, "large-blobs", reporeader.DefaultLargeBlobCount,
+		"number of largest blobs ever committed to report")
+
+	flags.StringSliceVar(&f.analyses, "analysis", nil,
+		"slow analyses to run up front instead of when a view first needs them: authorship, histories, size, or all (text and json output leave the others out)")
+}
+
+// readerOptions parses the analysis flags into the options of the repository reader.
+func (f *analysisFlags) readerOptions() ([]reporeader.Option, error) {
+	creditMode, err := reporeader.ParseCreditMode(f.credit)
+	if err != nil {
+		return nil, err
+	}
+
+	botMode, err := reporeader.ParseBotMode(f.bots)
+	if err != nil {
+		return nil, err
+	}
+
+	staleAfterWindow, err := gitcha.ParseWindow(f.staleAfter)
+	if err != nil {
+		return nil, err
+	}
+
+	patterns, err := reporeader.ParseIssuePatterns(f.issuePatterns)
+	if err != nil {
+		return nil, err
+	}
+
+	keyring, err := reporeader.ReadKeyring(f.keyringPaths...)
+	if err != nil {
+		return nil, err
+	}
+
+	analyses, err := parseAnalyses(f.analyses)
+	if err != nil {
+		return nil, err
+	}
+
+	return []reporeader.Option{
+		reporeader.WithSimilarity(f.similarity),
+		reporeader.WithCopyDetection(f.detectCopies),
+		reporeader.WithCoAuthorTrailers(f.coAuthorTrailers...),
+		reporeader.WithCreditMode(creditMode),
+		reporeader.WithBotMode(botMode),
+		reporeader.WithBotPatterns(f.botPatterns...),
+		reporeader.WithStaleAfter(staleAfterWindow),
+		reporeader.WithIssuePatterns(patterns...),
+		reporeader.WithKeyring(keyring),
+		reporeader.WithProtectedBranches(f.protectedBranches...),
+		reporeader.WithLargeBlobCount(f.largeBlobCount),
+		reporeader.WithAnalyses(analyses...),
+	}, nil
+}
+
+// parseAnalyses parses the names of the analyses to run up front, where all stands for every analysis.
+func parseAnalyses(names []string) ([]reporeader.Analysis, error) {
+	analyses := make([]reporeader.Analysis, 0, len(names))
+	for _, name := range names {
+		if name == "all" {
+			return reporeader.Analyses, nil
+		}
+
+		analysis, err := reporeader.ParseAnalysis(name)
+		if err != nil {
+			return nil, err
+		}
+		analyses = append(analyses, analysis)
+	}
+
+	return analyses, nil
+}
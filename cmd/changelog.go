@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"github.com/djyuhn/gitcha/cmd/gitcha"
+
+	"github.com/spf13/cobra"
+)
+
+func newChangelogCmd(analysis *analysisFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:     "changelog <from>..<to> [dir]",
+		Short:   "Generate a Markdown changelog between two revisions.",
+		Long:    "Generate a Markdown changelog of the commits between two revisions grouped into breaking changes, features, fixes and others based on Conventional Commits messages.",
+		Example: "gitcha changelog v1.0.0..v1.1.0",
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, err := gitcha.GetDirectoryFromArgs(args[1:])
+			if err != nil {
+				return err
+			}
+
+			readerOpts, err := analysis.readerOptions()
+			if err != nil {
+				return err
+			}
+
+			app, err := gitcha.NewApp(path, gitcha.AppOptions{ReaderOptions: readerOpts})
+			if err != nil {
+				return err
+			}
+
+			return app.GitchaChangelog(cmd.OutOrStdout(), args[0])
+		},
+	}
+}
@@ -0,0 +1,36 @@
+package cmd_test
+
+import (
+	"testing"
+
+	"github.com/djyuhn/gitcha/cmd"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangelogCmd(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should be registered as a subcommand of the root command", func(t *testing.T) {
+		t.Parallel()
+
+		rootCmd := cmd.NewRootCmd()
+
+		actual, _, err := rootCmd.Find([]string{"changelog"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "changelog", actual.Name())
+	})
+
+	t.Run("given no revision range should return error", func(t *testing.T) {
+		t.Parallel()
+
+		rootCmd := cmd.NewRootCmd()
+		rootCmd.SetArgs([]string{"changelog"})
+		rootCmd.SilenceUsage = true
+		rootCmd.SilenceErrors = true
+
+		assert.ErrorContains(t, rootCmd.Execute(), "accepts between 1 and 2 arg(s)")
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/djyuhn/gitcha/internal/browser"
 	"github.com/djyuhn/gitcha/internal/reporeader"
 	"github.com/djyuhn/gitcha/internal/tui"
 )
@@ -15,8 +16,18 @@ type App struct {
 	TuiProgram tea.Program
 }
 
-func NewApp(repoDirPath string, opts ...tea.ProgramOption) (*App, error) {
-	repoReader, err := reporeader.NewRepoReader(repoDirPath)
+// AppOptions configures the repository analysis and the TUI of an App.
+type AppOptions struct {
+	ReaderOptions []reporeader.Option
+	// OpenCommand is the command, followed by its arguments, that opens web pages. The default browser of the
+	// operating system is used when it is empty.
+	OpenCommand []string
+}
+
+// NewApp creates an App for the repository at repoDirPath, analyzed and shown as configured by appOpts, whose TUI
+// program is created with opts.
+func NewApp(repoDirPath string, appOpts AppOptions, opts ...tea.ProgramOption) (*App, error) {
+	repoReader, err := reporeader.NewRepoReader(repoDirPath, appOpts.ReaderOptions...)
 	if err != nil {
 		return nil, fmt.Errorf("NewApp: directory does not contain a repository: %w", err)
 	}
@@ -25,6 +36,7 @@ func NewApp(repoDirPath string, opts ...tea.ProgramOption) (*App, error) {
 	if err != nil {
 		return nil, fmt.Errorf("NewApp: error during creation of tui model: %w", err)
 	}
+	entryModel.Opener = browser.Opener{Command: appOpts.OpenCommand}
 
 	program := tea.NewProgram(entryModel, opts...)
 
@@ -40,6 +52,15 @@ func (a *App) GitchaTui() error {
 	return nil
 }
 
+// WriteCommitGraph generates a commit-graph file for the repository so later runs can walk the history faster.
+func (a *App) WriteCommitGraph() error {
+	if err := a.TuiModel.RepoReader.WriteCommitGraph(); err != nil {
+		return fmt.Errorf("WriteCommitGraph: unable to write the commit-graph file: %w", err)
+	}
+
+	return nil
+}
+
 // GetDirectoryFromArgs will attempt to get a directory from the given args.
 //   - If no args are provided the working directory is returned with a nil error.
 //   - If multiple args are provided the first argument alone will be evaluated.
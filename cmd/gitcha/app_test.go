@@ -25,7 +25,7 @@ func TestNewApp(t *testing.T) {
 		dirPath, _, err := gittest.CreateBasicRepo(ctx, t)
 		require.NoError(t, err)
 
-		app, err := gitcha.NewApp(dirPath)
+		app, err := gitcha.NewApp(dirPath, gitcha.AppOptions{})
 
 		assert.NoError(t, err)
 		assert.NotNil(t, app)
@@ -39,11 +39,22 @@ func TestNewApp(t *testing.T) {
 		require.Error(t, err)
 
 		expectedError := fmt.Errorf("NewApp: directory does not contain a repository")
-		app, err := gitcha.NewApp(repoDir)
+		app, err := gitcha.NewApp(repoDir, gitcha.AppOptions{})
 
 		assert.ErrorContains(t, err, expectedError.Error())
 		assert.Nil(t, app)
 	})
+
+	t.Run("given open command should configure the opener of the TUI", func(t *testing.T) {
+		t.Parallel()
+
+		dirPath := createOutputRepo(t)
+
+		app, err := gitcha.NewApp(dirPath, gitcha.AppOptions{OpenCommand: []string{"firefox", "--new-tab"}})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"firefox", "--new-tab"}, app.TuiModel.Opener.Command)
+	})
 }
 
 func TestApp_GitchaTui(t *testing.T) {
@@ -59,7 +70,7 @@ func TestApp_GitchaTui(t *testing.T) {
 		var buf bytes.Buffer
 		var in bytes.Buffer
 
-		app, err := gitcha.NewApp(dirPath, tea.WithInput(&in), tea.WithOutput(&buf))
+		app, err := gitcha.NewApp(dirPath, gitcha.AppOptions{}, tea.WithInput(&in), tea.WithOutput(&buf))
 		require.NoError(t, err)
 
 		go app.TuiProgram.Kill()
@@ -80,7 +91,7 @@ func TestApp_GitchaTui(t *testing.T) {
 		var buf bytes.Buffer
 		var in bytes.Buffer
 
-		app, err := gitcha.NewApp(dirPath, tea.WithInput(&in), tea.WithOutput(&buf))
+		app, err := gitcha.NewApp(dirPath, gitcha.AppOptions{}, tea.WithInput(&in), tea.WithOutput(&buf))
 		require.NoError(t, err)
 
 		go app.TuiProgram.Send(tea.Quit())
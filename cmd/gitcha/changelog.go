@@ -0,0 +1,48 @@
+package gitcha
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/djyuhn/gitcha/internal/changelog"
+)
+
+// ParseRevisionRange splits a revision range such as v1.0..v1.1 into the revision to start after and the revision to
+// end at. Either side may be empty, such as v1.0.. for the changes since v1.0, and a single revision is the end of
+// the range.
+func ParseRevisionRange(revisionRange string) (string, string, error) {
+	if strings.Contains(revisionRange, "...") {
+		return "", "", fmt.Errorf("ParseRevisionRange: symmetric difference ranges are not supported: %q", revisionRange)
+	}
+
+	from, to, found := strings.Cut(revisionRange, "..")
+	if !found {
+		from, to = "", revisionRange
+	}
+
+	if from == "" && to == "" {
+		return "", "", fmt.Errorf("ParseRevisionRange: invalid revision range %q", revisionRange)
+	}
+
+	return from, to, nil
+}
+
+// GitchaChangelog will write a Markdown changelog of the commits in revisionRange to w.
+func (a *App) GitchaChangelog(w io.Writer, revisionRange string) error {
+	from, to, err := ParseRevisionRange(revisionRange)
+	if err != nil {
+		return fmt.Errorf("GitchaChangelog: %w", err)
+	}
+
+	commits, err := a.TuiModel.RepoReader.GetCommitRange(from, to)
+	if err != nil {
+		return fmt.Errorf("GitchaChangelog: unable to get the commits of %s: %w", revisionRange, err)
+	}
+
+	if _, err := io.WriteString(w, changelog.New(commits).Markdown("Changelog "+revisionRange)); err != nil {
+		return fmt.Errorf("GitchaChangelog: unable to write the changelog: %w", err)
+	}
+
+	return nil
+}
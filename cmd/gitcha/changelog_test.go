@@ -0,0 +1,79 @@
+package gitcha_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/djyuhn/gitcha/cmd/gitcha"
+	"github.com/djyuhn/gitcha/gittest"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRevisionRange(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given revision ranges should return from and to revisions", func(t *testing.T) {
+		t.Parallel()
+
+		cases := map[string][2]string{
+			"v1.0..v1.1": {"v1.0", "v1.1"},
+			"v1.0..":     {"v1.0", ""},
+			"..v1.1":     {"", "v1.1"},
+			"v1.1":       {"", "v1.1"},
+		}
+
+		for revisionRange, expected := range cases {
+			from, to, err := gitcha.ParseRevisionRange(revisionRange)
+
+			assert.NoError(t, err, revisionRange)
+			assert.Equal(t, expected[0], from, revisionRange)
+			assert.Equal(t, expected[1], to, revisionRange)
+		}
+	})
+
+	t.Run("given empty or symmetric difference range should return error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, revisionRange := range []string{"..", "v1.0...v1.1"} {
+			_, _, err := gitcha.ParseRevisionRange(revisionRange)
+
+			assert.Error(t, err, revisionRange)
+		}
+	})
+}
+
+func TestApp_GitchaChangelog(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given revision range should write grouped markdown changelog", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+		author := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: start}
+		commits := []gittest.LocalCommit{
+			{Author: author, Message: "Initial commit", Files: map[string]string{"README.md": "# gitcha\n"}},
+			{Author: author, Message: "feat(cli): add changelog", Files: map[string]string{"cli.go": "cli\n"}},
+			{Author: author, Message: "fix: handle empty ranges", Files: map[string]string{"cli.go": "cli\nfix\n"}},
+		}
+		dirPath, _, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		app, err := gitcha.NewApp(dirPath, gitcha.AppOptions{})
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		err = app.GitchaChangelog(&buf, "HEAD~2..HEAD")
+		require.NoError(t, err)
+
+		actual := buf.String()
+
+		assert.Contains(t, actual, "# Changelog HEAD~2..HEAD\n")
+		assert.Contains(t, actual, "## Features\n\n- **cli:** add changelog (Gitcha One, ")
+		assert.Contains(t, actual, "## Fixes\n\n- handle empty ranges (Gitcha One, ")
+		assert.NotContains(t, actual, "Initial commit")
+	})
+}
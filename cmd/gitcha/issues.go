@@ -0,0 +1,67 @@
+package gitcha
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/djyuhn/gitcha/internal/reporeader"
+)
+
+// GitchaIssues will write the issues referenced by the commits in revisionRange to w, along with the commits without
+// any reference and the reference rate of every author. When strict is set it returns an error if any commit lacks a
+// reference, so the audit can fail a build.
+func (a *App) GitchaIssues(w io.Writer, revisionRange string, strict bool) error {
+	from, to, err := ParseRevisionRange(revisionRange)
+	if err != nil {
+		return fmt.Errorf("GitchaIssues: %w", err)
+	}
+
+	references, err := a.TuiModel.RepoReader.GetIssueReferences(from, to)
+	if err != nil {
+		return fmt.Errorf("GitchaIssues: unable to get the issue references of %s: %w", revisionRange, err)
+	}
+
+	report := strings.Builder{}
+	report.WriteString(fmt.Sprintf("Issue references in %s: %d of %d commits (%.1f%%)\n",
+		revisionRange, references.Referencing, references.Commits, references.Share()))
+
+	if len(references.Issues) > 0 {
+		report.WriteString("\nIssues:\n")
+		for _, issue := range references.SortedIssues() {
+			hashes := make([]string, 0, len(references.Issues[issue]))
+			for _, hash := range references.Issues[issue] {
+				hashes = append(hashes, reporeader.ShortHash(hash))
+			}
+			report.WriteString(fmt.Sprintf("  %s %s\n", issue, strings.Join(hashes, " ")))
+		}
+	}
+
+	if len(references.Unreferenced) > 0 {
+		report.WriteString("\nCommits without an issue reference:\n")
+		for _, commit := range references.Unreferenced {
+			subject, _, _ := strings.Cut(commit.Message, "\n")
+			report.WriteString(fmt.Sprintf("  %s %s (%s <%s>)\n",
+				reporeader.ShortHash(commit.Hash), strings.TrimSpace(subject), commit.Author.Name, commit.Author.Email))
+		}
+	}
+
+	if len(references.Authors) > 0 {
+		report.WriteString("\nAuthors:\n")
+		for _, email := range references.SortedAuthors() {
+			rate := references.Authors[email]
+			report.WriteString(fmt.Sprintf("  %s %d of %d commits (%.1f%%)\n", email, rate.Referencing, rate.Commits, rate.Share()))
+		}
+	}
+
+	if _, err := io.WriteString(w, report.String()); err != nil {
+		return fmt.Errorf("GitchaIssues: unable to write the issue references: %w", err)
+	}
+
+	if strict && len(references.Unreferenced) > 0 {
+		return fmt.Errorf("GitchaIssues: %d of %d commits in %s do not reference an issue",
+			len(references.Unreferenced), references.Commits, revisionRange)
+	}
+
+	return nil
+}
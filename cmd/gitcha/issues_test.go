@@ -0,0 +1,82 @@
+package gitcha_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/djyuhn/gitcha/cmd/gitcha"
+	"github.com/djyuhn/gitcha/gittest"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_GitchaIssues(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+	authorOne := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: start}
+	authorTwo := object.Signature{Name: "Gitcha Two", Email: "gitcha2@gitcha.com", When: start.Add(time.Hour)}
+	commits := []gittest.LocalCommit{
+		{Author: authorOne, Message: "Initial commit", Files: map[string]string{"README.md": "# gitcha\n"}},
+		{Author: authorOne, Message: "Add the issues command (#7)", Files: map[string]string{"cli.go": "cli\n"}},
+		{Author: authorTwo, Message: "Fix typo", Files: map[string]string{"cli.go": "cli\nfix\n"}},
+		{Author: authorTwo, Message: "Handle empty ranges\n\nCloses #12", Files: map[string]string{"cli.go": "cli\nfix\nempty\n"}},
+	}
+
+	t.Run("given revision range should write issues, unreferenced commits and author rates", func(t *testing.T) {
+		t.Parallel()
+
+		dirPath, _, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		app, err := gitcha.NewApp(dirPath, gitcha.AppOptions{})
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		err = app.GitchaIssues(&buf, "HEAD~3..HEAD", false)
+		require.NoError(t, err)
+
+		actual := buf.String()
+
+		assert.Contains(t, actual, "Issue references in HEAD~3..HEAD: 2 of 3 commits (66.7%)\n")
+		assert.Regexp(t, `\n  #12 [0-9a-f]{7}\n  #7 [0-9a-f]{7}\n`, actual)
+		assert.Regexp(t, `Commits without an issue reference:\n  [0-9a-f]{7} Fix typo \(Gitcha Two <gitcha2@gitcha.com>\)\n`, actual)
+		assert.Contains(t, actual, "Authors:\n  gitcha2@gitcha.com 1 of 2 commits (50.0%)\n  gitcha1@gitcha.com 1 of 1 commits (100.0%)\n")
+		assert.NotContains(t, actual, "Initial commit")
+	})
+
+	t.Run("given strict audit and unreferenced commits should write the report and return error", func(t *testing.T) {
+		t.Parallel()
+
+		dirPath, _, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		app, err := gitcha.NewApp(dirPath, gitcha.AppOptions{})
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		err = app.GitchaIssues(&buf, "HEAD", true)
+
+		assert.ErrorContains(t, err, "2 of 4 commits in HEAD do not reference an issue")
+		assert.Contains(t, buf.String(), "Commits without an issue reference:")
+	})
+
+	t.Run("given strict audit and only referencing commits should return nil error", func(t *testing.T) {
+		t.Parallel()
+
+		dirPath, _, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		app, err := gitcha.NewApp(dirPath, gitcha.AppOptions{})
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		err = app.GitchaIssues(&buf, "HEAD~1..HEAD", true)
+
+		assert.NoError(t, err)
+		assert.NotContains(t, buf.String(), "Commits without an issue reference:")
+	})
+}
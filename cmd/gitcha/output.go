@@ -0,0 +1,109 @@
+package gitcha
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/djyuhn/gitcha/internal/reporeader"
+	"github.com/djyuhn/gitcha/internal/tui/overview"
+)
+
+const (
+	day  = 24 * time.Hour
+	week = 7 * day
+	year = 365 * day
+)
+
+// OutputFormat is a non-interactive format the repository details can be written in.
+type OutputFormat string
+
+const (
+	OutputText OutputFormat = "text"
+	OutputJSON OutputFormat = "json"
+)
+
+// OutputOptions configures the non-interactive output of Gitcha.
+type OutputOptions struct {
+	Format OutputFormat
+	// HotspotWindow is the window of time, back from the most recent change, that file hotspots are ranked over. A
+	// zero window covers the whole history.
+	HotspotWindow time.Duration
+}
+
+// report is the machine-readable output of Gitcha.
+type report struct {
+	reporeader.RepoDetails
+	Hotspots []reporeader.FileStats `json:"hotspots"`
+}
+
+// ParseOutputFormat returns the OutputFormat matching format or an error if the format is not supported.
+func ParseOutputFormat(format string) (OutputFormat, error) {
+	switch OutputFormat(format) {
+	case OutputText, OutputJSON:
+		return OutputFormat(format), nil
+	default:
+		return "", fmt.Errorf("ParseOutputFormat: unsupported output format %q", format)
+	}
+}
+
+// ParseWindow parses a window of time such as 90d, 4w or 1y, or any duration understood by time.ParseDuration. The
+// window "all" covers the whole history and is returned as zero.
+func ParseWindow(window string) (time.Duration, error) {
+	if window == "" || window == "all" {
+		return 0, nil
+	}
+
+	units := map[string]time.Duration{"d": day, "w": week, "y": year}
+	for suffix, unit := range units {
+		if !strings.HasSuffix(window, suffix) {
+			continue
+		}
+		count, err := strconv.Atoi(strings.TrimSuffix(window, suffix))
+		if err != nil || count < 0 {
+			return 0, fmt.Errorf("ParseWindow: invalid window %q", window)
+		}
+		return time.Duration(count) * unit, nil
+	}
+
+	duration, err := time.ParseDuration(window)
+	if err != nil || duration < 0 {
+		return 0, fmt.Errorf("ParseWindow: invalid window %q", window)
+	}
+
+	return duration, nil
+}
+
+// GitchaOutput will write the repository details to w in a non-interactive format instead of starting the TUI
+// program.
+func (a *App) GitchaOutput(w io.Writer, opts OutputOptions) error {
+	details, err := a.TuiModel.RepoReader.GetRepoDetails()
+	if err != nil {
+		return fmt.Errorf("GitchaOutput: unable to get the repository details: %w", err)
+	}
+
+	switch opts.Format {
+	case OutputText:
+		if _, err := io.WriteString(w, overview.NewOverview(details).View()); err != nil {
+			return fmt.Errorf("GitchaOutput: unable to write text output: %w", err)
+		}
+	case OutputJSON:
+		output := report{
+			RepoDetails: details,
+			Hotspots:    reporeader.Hotspots(details.FileHistories, opts.HotspotWindow),
+		}
+
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(output); err != nil {
+			return fmt.Errorf("GitchaOutput: unable to write json output: %w", err)
+		}
+	default:
+		return fmt.Errorf("GitchaOutput: unsupported output format %q", opts.Format)
+	}
+
+	return nil
+}
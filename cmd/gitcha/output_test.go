@@ -0,0 +1,159 @@
+package gitcha_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/djyuhn/gitcha/cmd/gitcha"
+	"github.com/djyuhn/gitcha/gittest"
+	"github.com/djyuhn/gitcha/internal/reporeader"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOutputFormat(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given supported formats should return output format and nil error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, format := range []gitcha.OutputFormat{gitcha.OutputText, gitcha.OutputJSON} {
+			actual, err := gitcha.ParseOutputFormat(string(format))
+
+			assert.Equal(t, format, actual)
+			assert.NoError(t, err)
+		}
+	})
+
+	t.Run("given unsupported format should return empty format and error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedError := fmt.Errorf("ParseOutputFormat: unsupported output format %q", "xml")
+		actual, err := gitcha.ParseOutputFormat("xml")
+
+		assert.Empty(t, actual)
+		assert.ErrorContains(t, err, expectedError.Error())
+	})
+}
+
+func TestApp_GitchaOutput(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given json format should write repository details as json", func(t *testing.T) {
+		t.Parallel()
+
+		dirPath := createOutputRepo(t)
+		app, err := gitcha.NewApp(dirPath, gitcha.AppOptions{
+			ReaderOptions: []reporeader.Option{reporeader.WithAnalyses(reporeader.AnalysisHistories)},
+		})
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		err = app.GitchaOutput(&buf, gitcha.OutputOptions{Format: gitcha.OutputJSON})
+		require.NoError(t, err)
+
+		var actual struct {
+			reporeader.RepoDetails
+			Hotspots []reporeader.FileStats `json:"hotspots"`
+		}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &actual))
+
+		assert.Len(t, actual.AuthorsCommits["gitcha1@gitcha.com"], 1)
+		assert.Equal(t, 1, actual.Punchcard[time.Monday][9])
+		require.Len(t, actual.Hotspots, 1)
+		assert.Equal(t, "README.md", actual.Hotspots[0].Path)
+	})
+
+	t.Run("given json format and default analyses should list the deferred analyses without hotspots", func(t *testing.T) {
+		t.Parallel()
+
+		dirPath := createOutputRepo(t)
+		app, err := gitcha.NewApp(dirPath, gitcha.AppOptions{})
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		err = app.GitchaOutput(&buf, gitcha.OutputOptions{Format: gitcha.OutputJSON})
+		require.NoError(t, err)
+
+		var actual struct {
+			reporeader.RepoDetails
+			Hotspots []reporeader.FileStats `json:"hotspots"`
+		}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &actual))
+
+		assert.Equal(t, reporeader.Analyses, actual.Deferred)
+		assert.Empty(t, actual.Hotspots)
+	})
+
+	t.Run("given text format should write overview", func(t *testing.T) {
+		t.Parallel()
+
+		dirPath := createOutputRepo(t)
+		app, err := gitcha.NewApp(dirPath, gitcha.AppOptions{})
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		err = app.GitchaOutput(&buf, gitcha.OutputOptions{Format: gitcha.OutputText})
+
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "Punchcard:")
+		assert.Contains(t, buf.String(), "gitcha1@gitcha.com")
+	})
+}
+
+func TestParseWindow(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		window   string
+		expected time.Duration
+	}{
+		"given all should return zero":             {window: "all", expected: 0},
+		"given days should return days":            {window: "90d", expected: 90 * 24 * time.Hour},
+		"given weeks should return weeks":          {window: "4w", expected: 4 * 7 * 24 * time.Hour},
+		"given years should return 365 day years":  {window: "1y", expected: 365 * 24 * time.Hour},
+		"given go duration should return duration": {window: "36h", expected: 36 * time.Hour},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := gitcha.ParseWindow(test.window)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+
+	t.Run("given invalid window should return error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedError := fmt.Errorf("ParseWindow: invalid window %q", "soon")
+		_, err := gitcha.ParseWindow("soon")
+
+		assert.ErrorContains(t, err, expectedError.Error())
+	})
+}
+
+func createOutputRepo(t *testing.T) string {
+	t.Helper()
+
+	commits := []gittest.LocalCommit{
+		{
+			Author:  object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)},
+			Message: "Initial commit",
+			Files:   map[string]string{"README.md": "# gitcha\n"},
+		},
+	}
+	dirPath, _, err := gittest.CreateLocalRepo(t, commits)
+	require.NoError(t, err)
+
+	return dirPath
+}
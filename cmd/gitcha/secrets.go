@@ -0,0 +1,74 @@
+package gitcha
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/djyuhn/gitcha/internal/reporeader"
+	"github.com/djyuhn/gitcha/internal/secrets"
+)
+
+// dateLayout formats the date of a commit in reports.
+const dateLayout = "2006-01-02"
+
+// SecretsOptions configures the secret scan of Gitcha.
+type SecretsOptions struct {
+	Scanner *secrets.Scanner
+	Format  OutputFormat
+	// Strict makes the scan return an error when any possible secret is found, so it can fail a build.
+	Strict bool
+}
+
+// GitchaSecrets will scan the history of every reference for possible secrets and write the findings to w in the
+// format of opts.
+func (a *App) GitchaSecrets(w io.Writer, opts SecretsOptions) error {
+	found, err := a.TuiModel.RepoReader.GetSecrets(opts.Scanner)
+	if err != nil {
+		return fmt.Errorf("GitchaSecrets: unable to scan for secrets: %w", err)
+	}
+
+	switch opts.Format {
+	case OutputText:
+		if _, err := io.WriteString(w, formatSecrets(found)); err != nil {
+			return fmt.Errorf("GitchaSecrets: unable to write text output: %w", err)
+		}
+	case OutputJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(found); err != nil {
+			return fmt.Errorf("GitchaSecrets: unable to write json output: %w", err)
+		}
+	default:
+		return fmt.Errorf("GitchaSecrets: unsupported output format %q", opts.Format)
+	}
+
+	if opts.Strict && len(found.Findings) > 0 {
+		return fmt.Errorf("GitchaSecrets: found %d possible secrets", len(found.Findings))
+	}
+
+	return nil
+}
+
+// formatSecrets lists the findings one per line, with the commit, file and line that introduced them.
+func formatSecrets(found reporeader.Secrets) string {
+	report := strings.Builder{}
+	report.WriteString(fmt.Sprintf("Scanned %d commits: %d possible secrets, %d still at HEAD\n",
+		found.Commits, len(found.Findings), found.AtHead()))
+
+	if len(found.Findings) > 0 {
+		report.WriteString("\n")
+	}
+	for _, finding := range found.Findings {
+		atHead := ""
+		if finding.AtHead {
+			atHead = " [at HEAD]"
+		}
+		report.WriteString(fmt.Sprintf("%s %s %s:%d %s %s (%s <%s>)%s\n",
+			reporeader.ShortHash(finding.Hash), finding.Date.Format(dateLayout), finding.Path, finding.Line, finding.Rule,
+			finding.Secret, finding.Author.Name, finding.Author.Email, atHead))
+	}
+
+	return report.String()
+}
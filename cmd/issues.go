@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"github.com/djyuhn/gitcha/cmd/gitcha"
+
+	"github.com/spf13/cobra"
+)
+
+func newIssuesCmd(analysis *analysisFlags) *cobra.Command {
+	var strict bool
+
+	issuesCmd := &cobra.Command{
+		Use:     "issues <from>..<to> [dir]",
+		Short:   "Audit the issue references of the commits between two revisions.",
+		Long:    "Audit the issue tracker references, such as #123, in the messages of the commits between two revisions and list the referenced issues, the commits without any reference and the reference rate of every author. Jira style keys such as JIRA-456 are not matched by default and need an --issue-pattern for the project key, which replaces the default pattern for #123 unless that is given as well.",
+		Example: "gitcha issues v1.0.0..v1.1.0 --issue-pattern 'GITCHA-\\d+' --strict",
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, err := gitcha.GetDirectoryFromArgs(args[1:])
+			if err != nil {
+				return err
+			}
+
+			readerOpts, err := analysis.readerOptions()
+			if err != nil {
+				return err
+			}
+
+			app, err := gitcha.NewApp(path, gitcha.AppOptions{ReaderOptions: readerOpts})
+			if err != nil {
+				return err
+			}
+
+			return app.GitchaIssues(cmd.OutOrStdout(), args[0], strict)
+		},
+	}
+
+	issuesCmd.Flags().BoolVar(&strict, "strict", false,
+		"fail when any commit in the range does not reference an issue")
+
+	return issuesCmd
+}
@@ -0,0 +1,89 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/djyuhn/gitcha/cmd"
+	"github.com/djyuhn/gitcha/gittest"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssuesCmd(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should be registered as a subcommand of the root command", func(t *testing.T) {
+		t.Parallel()
+
+		rootCmd := cmd.NewRootCmd()
+
+		actual, _, err := rootCmd.Find([]string{"issues"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "issues", actual.Name())
+	})
+
+	t.Run("given no revision range should return error", func(t *testing.T) {
+		t.Parallel()
+
+		rootCmd := cmd.NewRootCmd()
+		rootCmd.SetArgs([]string{"issues"})
+		rootCmd.SilenceUsage = true
+		rootCmd.SilenceErrors = true
+
+		assert.ErrorContains(t, rootCmd.Execute(), "accepts between 1 and 2 arg(s)")
+	})
+
+	t.Run("should have strict flag defaulting to false", func(t *testing.T) {
+		t.Parallel()
+
+		rootCmd := cmd.NewRootCmd()
+		issuesCmd, _, err := rootCmd.Find([]string{"issues"})
+		require.NoError(t, err)
+		flag := issuesCmd.Flags().Lookup("strict")
+
+		require.NotNil(t, flag)
+		assert.Equal(t, "false", flag.DefValue)
+	})
+
+	t.Run("given an invalid issue pattern should return error", func(t *testing.T) {
+		t.Parallel()
+
+		rootCmd := cmd.NewRootCmd()
+		rootCmd.SetArgs([]string{"issues", "HEAD", t.TempDir(), "--issue-pattern", "#(\\d+"})
+		rootCmd.SilenceUsage = true
+		rootCmd.SilenceErrors = true
+
+		assert.ErrorContains(t, rootCmd.Execute(), "invalid issue pattern")
+	})
+
+	t.Run("given root analysis flags should apply them to the audit", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+		author := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: start}
+		bot := object.Signature{Name: "dependabot[bot]", Email: "49699333+dependabot[bot]@users.noreply.github.com", When: start}
+		dir, _, err := gittest.CreateLocalRepo(t, []gittest.LocalCommit{
+			{Author: author, Message: "Initial commit", Files: map[string]string{"README.md": "# gitcha\n"}},
+			{Author: author, Message: "Add the cache (#12)", Files: map[string]string{"cache.go": "package cache\n"}},
+			{Author: bot, Message: "Bump go-git", Files: map[string]string{"go.mod": "module gitcha\n"}},
+		})
+		require.NoError(t, err)
+
+		rootCmd := cmd.NewRootCmd()
+		issuesCmd, _, err := rootCmd.Find([]string{"issues"})
+		require.NoError(t, err)
+		var buf bytes.Buffer
+		issuesCmd.SetOut(&buf)
+		rootCmd.SetArgs([]string{"--bots", "exclude", "issues", "HEAD~2..HEAD", dir})
+
+		require.NoError(t, rootCmd.Execute())
+
+		assert.Contains(t, buf.String(), "1 of 1 commits (100.0%)")
+		assert.NotContains(t, buf.String(), "dependabot")
+	})
+}
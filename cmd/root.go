@@ -4,6 +4,7 @@ import (
 	"os"
 
 	"github.com/djyuhn/gitcha/cmd/gitcha"
+	"github.com/djyuhn/gitcha/internal/browser"
 
 	"github.com/spf13/cobra"
 )
@@ -13,7 +14,13 @@ type RootCmd struct {
 }
 
 func NewRootCmd() RootCmd {
-	return RootCmd{
+	var writeCommitGraph bool
+	var output string
+	var hotspotWindow string
+	var openCommand string
+	analysis := &analysisFlags{}
+
+	rootCmd := RootCmd{
 		Command: cobra.Command{
 			Use:     "gitcha [-D dir]",
 			Short:   "A command-line tool to get Git information.",
@@ -26,11 +33,39 @@ func NewRootCmd() RootCmd {
 					return err
 				}
 
-				app, err := gitcha.NewApp(path)
+				readerOpts, err := analysis.readerOptions()
+				if err != nil {
+					return err
+				}
+
+				app, err := gitcha.NewApp(path, gitcha.AppOptions{
+					ReaderOptions: readerOpts,
+					OpenCommand:   browser.ParseCommand(openCommand),
+				})
 				if err != nil {
 					return err
 				}
 
+				if writeCommitGraph {
+					if err := app.WriteCommitGraph(); err != nil {
+						return err
+					}
+				}
+
+				if output != "" {
+					format, err := gitcha.ParseOutputFormat(output)
+					if err != nil {
+						return err
+					}
+
+					window, err := gitcha.ParseWindow(hotspotWindow)
+					if err != nil {
+						return err
+					}
+
+					return app.GitchaOutput(cmd.OutOrStdout(), gitcha.OutputOptions{Format: format, HotspotWindow: window})
+				}
+
 				if err := app.GitchaTui(); err != nil {
 					return err
 				}
@@ -39,6 +74,26 @@ func NewRootCmd() RootCmd {
 			},
 		},
 	}
+
+	rootCmd.Flags().BoolVar(&writeCommitGraph, "write-commit-graph", false,
+		"generate a commit-graph file for the repository to speed up history traversal")
+
+	rootCmd.Flags().StringVarP(&output, "output", "o", "",
+		"write the repository details in a non-interactive format instead of starting the TUI (text, json)")
+
+	rootCmd.Flags().StringVar(&hotspotWindow, "hotspot-window", "all",
+		"window back from the latest change that file hotspots are ranked over in the output (e.g. 90d, 4w, 1y, all)")
+
+	rootCmd.Flags().StringVar(&openCommand, "open-command", "",
+		"command the TUI runs with a web page address to open it, such as \"firefox --new-tab\" (default: the system browser)")
+
+	analysis.register(rootCmd.PersistentFlags())
+
+	rootCmd.AddCommand(newChangelogCmd(analysis))
+	rootCmd.AddCommand(newIssuesCmd(analysis))
+	rootCmd.AddCommand(newSecretsCmd(analysis))
+
+	return rootCmd
 }
 
 func Execute() {
@@ -1,12 +1,15 @@
 package cmd_test
 
 import (
+	"strconv"
 	"testing"
 
 	"github.com/djyuhn/gitcha/cmd"
+	"github.com/djyuhn/gitcha/internal/reporeader"
 
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewRootCmd(t *testing.T) {
@@ -61,3 +64,163 @@ func TestRootCmd_Args(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestRootCmd_Flags(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should have write-commit-graph flag defaulting to false", func(t *testing.T) {
+		t.Parallel()
+
+		rootCmd := cmd.NewRootCmd()
+		flag := rootCmd.Flags().Lookup("write-commit-graph")
+
+		require.NotNil(t, flag)
+		assert.Equal(t, "false", flag.DefValue)
+	})
+
+	t.Run("should have output flag with o shorthand defaulting to empty", func(t *testing.T) {
+		t.Parallel()
+
+		rootCmd := cmd.NewRootCmd()
+		flag := rootCmd.Flags().ShorthandLookup("o")
+
+		require.NotNil(t, flag)
+		assert.Equal(t, "output", flag.Name)
+		assert.Equal(t, "", flag.DefValue)
+	})
+	t.Run("should have similarity flag defaulting to 50", func(t *testing.T) {
+		t.Parallel()
+
+		rootCmd := cmd.NewRootCmd()
+		flag := rootCmd.PersistentFlags().Lookup("similarity")
+
+		require.NotNil(t, flag)
+		assert.Equal(t, "50", flag.DefValue)
+	})
+
+	t.Run("should have detect-copies flag defaulting to true", func(t *testing.T) {
+		t.Parallel()
+
+		rootCmd := cmd.NewRootCmd()
+		flag := rootCmd.PersistentFlags().Lookup("detect-copies")
+
+		require.NotNil(t, flag)
+		assert.Equal(t, "true", flag.DefValue)
+	})
+	t.Run("should have credit flag defaulting to primary", func(t *testing.T) {
+		t.Parallel()
+
+		rootCmd := cmd.NewRootCmd()
+		flag := rootCmd.PersistentFlags().Lookup("credit")
+
+		require.NotNil(t, flag)
+		assert.Equal(t, "primary", flag.DefValue)
+	})
+
+	t.Run("should have co-author-trailer flag defaulting to Co-authored-by", func(t *testing.T) {
+		t.Parallel()
+
+		rootCmd := cmd.NewRootCmd()
+		flag := rootCmd.PersistentFlags().Lookup("co-author-trailer")
+
+		require.NotNil(t, flag)
+		assert.Equal(t, "[Co-authored-by]", flag.DefValue)
+	})
+	t.Run("should have bots flag defaulting to include", func(t *testing.T) {
+		t.Parallel()
+
+		rootCmd := cmd.NewRootCmd()
+		flag := rootCmd.PersistentFlags().Lookup("bots")
+
+		require.NotNil(t, flag)
+		assert.Equal(t, "include", flag.DefValue)
+	})
+
+	t.Run("should have stale-after flag defaulting to 90 days", func(t *testing.T) {
+		t.Parallel()
+
+		rootCmd := cmd.NewRootCmd()
+		flag := rootCmd.PersistentFlags().Lookup("stale-after")
+
+		require.NotNil(t, flag)
+		assert.Equal(t, "90d", flag.DefValue)
+	})
+
+	t.Run("should have open-command flag defaulting to the system browser", func(t *testing.T) {
+		t.Parallel()
+
+		rootCmd := cmd.NewRootCmd()
+		flag := rootCmd.Flags().Lookup("open-command")
+
+		require.NotNil(t, flag)
+		assert.Empty(t, flag.DefValue)
+	})
+
+	t.Run("should have issue-pattern flag defaulting to the default issue patterns", func(t *testing.T) {
+		t.Parallel()
+
+		rootCmd := cmd.NewRootCmd()
+		flag := rootCmd.PersistentFlags().Lookup("issue-pattern")
+
+		require.NotNil(t, flag)
+
+		actual, err := rootCmd.PersistentFlags().GetStringArray("issue-pattern")
+		require.NoError(t, err)
+		assert.Equal(t, reporeader.DefaultIssuePatterns, actual)
+	})
+
+	t.Run("should have keyring and protected-branch flags defaulting to none", func(t *testing.T) {
+		t.Parallel()
+
+		rootCmd := cmd.NewRootCmd()
+
+		for _, name := range []string{"keyring", "protected-branch"} {
+			flag := rootCmd.PersistentFlags().Lookup(name)
+
+			require.NotNil(t, flag, name)
+			assert.Equal(t, "[]", flag.DefValue, name)
+		}
+	})
+
+	t.Run("given a missing keyring file should return error", func(t *testing.T) {
+		t.Parallel()
+
+		rootCmd := cmd.NewRootCmd()
+		rootCmd.SetArgs([]string{t.TempDir(), "--keyring", "missing.asc"})
+		rootCmd.SilenceUsage = true
+		rootCmd.SilenceErrors = true
+
+		assert.ErrorContains(t, rootCmd.Execute(), "unable to read keyring")
+	})
+
+	t.Run("should have large-blobs flag defaulting to the default large blob count", func(t *testing.T) {
+		t.Parallel()
+
+		rootCmd := cmd.NewRootCmd()
+		flag := rootCmd.PersistentFlags().Lookup("large-blobs")
+
+		require.NotNil(t, flag)
+		assert.Equal(t, strconv.Itoa(reporeader.DefaultLargeBlobCount), flag.DefValue)
+	})
+
+	t.Run("should have analysis flag defaulting to none", func(t *testing.T) {
+		t.Parallel()
+
+		rootCmd := cmd.NewRootCmd()
+		flag := rootCmd.PersistentFlags().Lookup("analysis")
+
+		require.NotNil(t, flag)
+		assert.Equal(t, "[]", flag.DefValue)
+	})
+
+	t.Run("given an unsupported analysis should return error", func(t *testing.T) {
+		t.Parallel()
+
+		rootCmd := cmd.NewRootCmd()
+		rootCmd.SetArgs([]string{t.TempDir(), "--analysis", "everything"})
+		rootCmd.SilenceUsage = true
+		rootCmd.SilenceErrors = true
+
+		assert.ErrorContains(t, rootCmd.Execute(), "unsupported analysis")
+	})
+}
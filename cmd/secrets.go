@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"github.com/djyuhn/gitcha/cmd/gitcha"
+	"github.com/djyuhn/gitcha/internal/secrets"
+
+	"github.com/spf13/cobra"
+)
+
+// secretsLong describes the secrets command along with the format of its config file.
+const secretsLong = `Scan the lines added by every commit reachable from HEAD or from any branch, remote-tracking branch or tag for credentials such as cloud provider keys, tokens and private keys, and for strings of high entropy, reporting the commit, file and author that introduced them and whether they are still present at HEAD.
+
+The rules can be extended with a JSON config file. Rules with the id of a default rule replace it, and a rule reports the first capture group of its pattern when it has one:
+
+  {
+    "disableDefaultRules": false,
+    "rules": [{"id": "internal-token", "description": "Internal token", "pattern": "itk_([A-Za-z0-9]{32})", "minEntropy": 3.5}],
+    "allowlist": {"paths": ["testdata/*", "*.md"], "patterns": ["EXAMPLE"]}
+  }`
+
+func newSecretsCmd(analysis *analysisFlags) *cobra.Command {
+	var configPath string
+	var output string
+	var strict bool
+
+	secretsCmd := &cobra.Command{
+		Use:     "secrets [dir]",
+		Short:   "Scan the history for leaked secrets.",
+		Long:    secretsLong,
+		Example: "gitcha secrets --config secrets.json --strict",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, err := gitcha.GetDirectoryFromArgs(args)
+			if err != nil {
+				return err
+			}
+
+			format, err := gitcha.ParseOutputFormat(output)
+			if err != nil {
+				return err
+			}
+
+			config := secrets.Config{}
+			if configPath != "" {
+				config, err = secrets.ReadConfig(configPath)
+				if err != nil {
+					return err
+				}
+			}
+
+			scanner, err := secrets.NewScanner(config)
+			if err != nil {
+				return err
+			}
+
+			readerOpts, err := analysis.readerOptions()
+			if err != nil {
+				return err
+			}
+
+			app, err := gitcha.NewApp(path, gitcha.AppOptions{ReaderOptions: readerOpts})
+			if err != nil {
+				return err
+			}
+
+			return app.GitchaSecrets(cmd.OutOrStdout(), gitcha.SecretsOptions{Scanner: scanner, Format: format, Strict: strict})
+		},
+	}
+
+	secretsCmd.Flags().StringVar(&configPath, "config", "",
+		"JSON file with additional rules and an allowlist of paths and secrets")
+	secretsCmd.Flags().StringVarP(&output, "output", "o", string(gitcha.OutputText),
+		"format of the findings: text or json")
+	secretsCmd.Flags().BoolVar(&strict, "strict", false,
+		"fail when any possible secret is found")
+
+	return secretsCmd
+}
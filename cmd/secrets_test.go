@@ -0,0 +1,53 @@
+package cmd_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/djyuhn/gitcha/cmd"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretsCmd(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should be registered as a subcommand of the root command", func(t *testing.T) {
+		t.Parallel()
+
+		rootCmd := cmd.NewRootCmd()
+
+		actual, _, err := rootCmd.Find([]string{"secrets"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "secrets", actual.Name())
+	})
+
+	t.Run("should have output flag defaulting to text", func(t *testing.T) {
+		t.Parallel()
+
+		rootCmd := cmd.NewRootCmd()
+		secretsCmd, _, err := rootCmd.Find([]string{"secrets"})
+		require.NoError(t, err)
+		flag := secretsCmd.Flags().Lookup("output")
+
+		require.NotNil(t, flag)
+		assert.Equal(t, "text", flag.DefValue)
+	})
+
+	t.Run("given an invalid config file should return error", func(t *testing.T) {
+		t.Parallel()
+
+		config := filepath.Join(t.TempDir(), "secrets.json")
+		require.NoError(t, os.WriteFile(config, []byte(`{"rules": [{"id": "broken", "pattern": "(token"}]}`), 0o600))
+
+		rootCmd := cmd.NewRootCmd()
+		rootCmd.SetArgs([]string{"secrets", t.TempDir(), "--config", config})
+		rootCmd.SilenceUsage = true
+		rootCmd.SilenceErrors = true
+
+		assert.ErrorContains(t, rootCmd.Execute(), "invalid pattern of rule broken")
+	})
+}
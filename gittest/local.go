@@ -0,0 +1,139 @@
+package gittest
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/require"
+)
+
+// LocalCommit describes a commit to create with CreateLocalRepo.
+//
+// Files are written relative to the repository root before committing and Removed paths are deleted from the
+// worktree. If Committer is nil the Author is used as the committer. Parents are indexes of earlier commits to use as
+// the parents of the commit; when set the worktree is first checked out at the first parent. Sign, when set, is called
+// with the encoded commit without a signature and returns the armored signature to store in its gpgsig header. Once
+// all commits are created the master branch points at the last one and HEAD is attached to it.
+type LocalCommit struct {
+	Author    object.Signature
+	Committer *object.Signature
+	Message   string
+	Files     map[string]string
+	Removed   []string
+	Parents   []int
+	Sign      func(payload []byte) (string, error)
+}
+
+// CreateLocalRepo will return the directory path of the repository, the repository, and will return an error.
+// The repository is initialized on disk in a temporary directory and the given commits are created in order. Unlike
+// the container backed helpers this does not require Docker.
+func CreateLocalRepo(t testing.TB, commits []LocalCommit) (string, *git.Repository, error) {
+	t.Helper()
+
+	testDir := t.TempDir()
+	repo, err := git.PlainInit(testDir, false)
+	if err != nil {
+		return testDir, nil, err
+	}
+
+	wt, err := repo.Worktree()
+	require.NoError(t, err)
+
+	hashes := make([]plumbing.Hash, 0, len(commits))
+	for _, commit := range commits {
+		parents := make([]plumbing.Hash, 0, len(commit.Parents))
+		for _, parent := range commit.Parents {
+			parents = append(parents, hashes[parent])
+		}
+		if len(parents) > 0 {
+			require.NoError(t, wt.Checkout(&git.CheckoutOptions{Hash: parents[0], Force: true}))
+		}
+
+		for path, content := range commit.Files {
+			fullPath := filepath.Join(testDir, filepath.FromSlash(path))
+			require.NoError(t, os.MkdirAll(filepath.Dir(fullPath), 0o755))
+			require.NoError(t, os.WriteFile(fullPath, []byte(content), 0o600))
+			_, err = wt.Add(path)
+			require.NoError(t, err)
+		}
+		for _, path := range commit.Removed {
+			_, err = wt.Remove(path)
+			require.NoError(t, err)
+		}
+
+		author := commit.Author
+		committer := commit.Committer
+		if committer == nil {
+			committer = &author
+		}
+
+		hash, err := wt.Commit(commit.Message, &git.CommitOptions{Author: &author, Committer: committer, Parents: parents})
+		if err != nil {
+			return testDir, repo, err
+		}
+		if commit.Sign != nil {
+			hash, err = signCommit(repo, hash, commit.Sign)
+			if err != nil {
+				return testDir, repo, err
+			}
+		}
+		hashes = append(hashes, hash)
+	}
+
+	if len(hashes) > 0 {
+		require.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference(plumbing.Master, hashes[len(hashes)-1])))
+		require.NoError(t, repo.Storer.SetReference(plumbing.NewSymbolicReference(plumbing.HEAD, plumbing.Master)))
+	}
+
+	return testDir, repo, nil
+}
+
+// signCommit replaces the commit at hash with a copy signed by sign and moves the HEAD reference to the copy.
+func signCommit(repo *git.Repository, hash plumbing.Hash, sign func(payload []byte) (string, error)) (plumbing.Hash, error) {
+	commit, err := repo.CommitObject(hash)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	unsigned := &plumbing.MemoryObject{}
+	if err := commit.EncodeWithoutSignature(unsigned); err != nil {
+		return plumbing.ZeroHash, err
+	}
+	reader, err := unsigned.Reader()
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	payload, err := io.ReadAll(reader)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	commit.PGPSignature, err = sign(payload)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	signed := repo.Storer.NewEncodedObject()
+	if err := commit.Encode(signed); err != nil {
+		return plumbing.ZeroHash, err
+	}
+	signedHash, err := repo.Storer.SetEncodedObject(signed)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	if err := repo.Storer.SetReference(plumbing.NewHashReference(head.Name(), signedHash)); err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	return signedHash, nil
+}
@@ -0,0 +1,52 @@
+package browser
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// Opener opens web pages by running Command with the address of the page appended to its arguments. An empty Command
+// uses the default opener of the operating system.
+type Opener struct {
+	Command []string
+}
+
+// ParseCommand splits a command line such as "firefox --new-tab" into the command of an Opener. Arguments are
+// separated by spaces and cannot be quoted.
+func ParseCommand(command string) []string {
+	return strings.Fields(command)
+}
+
+// DefaultCommand returns the command that opens web pages in the default browser of the operating system.
+func DefaultCommand() []string {
+	switch runtime.GOOS {
+	case "darwin":
+		return []string{"open"}
+	case "windows":
+		return []string{"rundll32", "url.dll,FileProtocolHandler"}
+	default:
+		return []string{"xdg-open"}
+	}
+}
+
+// Open starts the opener command for url without waiting for the browser to exit.
+func (o Opener) Open(url string) error {
+	command := o.Command
+	if len(command) == 0 {
+		command = DefaultCommand()
+	}
+
+	args := append(append(make([]string, 0, len(command)), command[1:]...), url)
+	cmd := exec.Command(command[0], args...) //nolint:gosec // The opener command is configured by the user.
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("Open: unable to run %s: %w", command[0], err)
+	}
+
+	go func() {
+		_ = cmd.Wait()
+	}()
+
+	return nil
+}
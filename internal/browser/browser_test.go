@@ -0,0 +1,63 @@
+package browser_test
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/djyuhn/gitcha/internal/browser"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCommand(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given command line should split it on spaces", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, []string{"firefox", "--new-tab"}, browser.ParseCommand(" firefox  --new-tab "))
+	})
+
+	t.Run("given empty command line should return no command", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, browser.ParseCommand(""))
+	})
+}
+
+func TestOpener_Open(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given command should run it with the url appended", func(t *testing.T) {
+		t.Parallel()
+
+		if runtime.GOOS == "windows" {
+			t.Skip("the opener command is a shell script")
+		}
+
+		output := filepath.Join(t.TempDir(), "opened")
+		opener := browser.Opener{Command: []string{"sh", "-c", `printf %s "$1" > "$0"`, output}}
+
+		err := opener.Open("https://github.com/djyuhn/gitcha")
+		require.NoError(t, err)
+
+		assert.Eventually(t, func() bool {
+			content, err := os.ReadFile(output)
+			return err == nil && string(content) == "https://github.com/djyuhn/gitcha"
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("given missing command should return error", func(t *testing.T) {
+		t.Parallel()
+
+		opener := browser.Opener{Command: []string{"gitcha-missing-opener"}}
+
+		err := opener.Open("https://github.com/djyuhn/gitcha")
+
+		assert.ErrorContains(t, err, "Open: unable to run gitcha-missing-opener")
+	})
+}
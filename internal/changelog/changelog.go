@@ -0,0 +1,121 @@
+package changelog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/djyuhn/gitcha/internal/reporeader"
+)
+
+// Entry is a change listed in a changelog.
+type Entry struct {
+	Scope       string
+	Description string
+	Authors     []string
+	Hash        string
+}
+
+// Changelog groups the changes between two revisions by their kind. Breaking changes are only listed under Breaking,
+// and commits that do not follow the Conventional Commits specification are listed under Others by their subject.
+type Changelog struct {
+	Breaking []Entry
+	Features []Entry
+	Fixes    []Entry
+	Others   []Entry
+}
+
+// New groups commits, ordered newest first, into a Changelog keeping their order within each group.
+func New(commits []reporeader.Commit) Changelog {
+	changelog := Changelog{
+		Breaking: make([]Entry, 0),
+		Features: make([]Entry, 0),
+		Fixes:    make([]Entry, 0),
+		Others:   make([]Entry, 0),
+	}
+
+	for _, commit := range commits {
+		entry := Entry{Authors: authorNames(commit), Hash: commit.Hash}
+
+		conventional, ok := reporeader.ParseConventionalCommit(commit.Message)
+		if !ok {
+			entry.Description, _, _ = strings.Cut(strings.TrimSpace(commit.Message), "\n")
+			changelog.Others = append(changelog.Others, entry)
+			continue
+		}
+
+		entry.Scope = conventional.Scope
+		entry.Description = conventional.Description
+
+		switch {
+		case conventional.Breaking:
+			changelog.Breaking = append(changelog.Breaking, entry)
+		case conventional.Type == "feat":
+			changelog.Features = append(changelog.Features, entry)
+		case conventional.Type == "fix":
+			changelog.Fixes = append(changelog.Fixes, entry)
+		default:
+			changelog.Others = append(changelog.Others, entry)
+		}
+	}
+
+	return changelog
+}
+
+// Markdown renders the changelog as a Markdown document under title. Empty groups are left out.
+func (c Changelog) Markdown(title string) string {
+	view := strings.Builder{}
+	view.WriteString(fmt.Sprintf("# %s\n", title))
+
+	sections := []struct {
+		heading string
+		entries []Entry
+	}{
+		{heading: "Breaking changes", entries: c.Breaking},
+		{heading: "Features", entries: c.Features},
+		{heading: "Fixes", entries: c.Fixes},
+		{heading: "Others", entries: c.Others},
+	}
+
+	empty := true
+	for _, section := range sections {
+		if len(section.entries) == 0 {
+			continue
+		}
+		empty = false
+
+		view.WriteString(fmt.Sprintf("\n## %s\n\n", section.heading))
+		for _, entry := range section.entries {
+			view.WriteString(formatEntry(entry) + "\n")
+		}
+	}
+
+	if empty {
+		view.WriteString("\nNo changes.\n")
+	}
+
+	return view.String()
+}
+
+// formatEntry renders entry as a Markdown list item such as "- **parser:** add arrays (Gitcha One, abc1234)".
+func formatEntry(entry Entry) string {
+	item := strings.Builder{}
+	item.WriteString("- ")
+	if entry.Scope != "" {
+		item.WriteString(fmt.Sprintf("**%s:** ", entry.Scope))
+	}
+	item.WriteString(entry.Description)
+
+	item.WriteString(fmt.Sprintf(" (%s, %s)", strings.Join(entry.Authors, ", "), reporeader.ShortHash(entry.Hash)))
+
+	return item.String()
+}
+
+// authorNames returns the names of the author and co-authors of commit.
+func authorNames(commit reporeader.Commit) []string {
+	names := []string{commit.Author.Name}
+	for _, coAuthor := range commit.CoAuthors {
+		names = append(names, coAuthor.Name)
+	}
+
+	return names
+}
@@ -0,0 +1,73 @@
+package changelog_test
+
+import (
+	"testing"
+
+	"github.com/djyuhn/gitcha/internal/changelog"
+	"github.com/djyuhn/gitcha/internal/reporeader"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given commits should group them into breaking changes, features, fixes and others", func(t *testing.T) {
+		t.Parallel()
+
+		author := reporeader.Author{Name: "Gitcha One", Email: "gitcha1@gitcha.com"}
+		coAuthor := reporeader.Author{Name: "Gitcha Two", Email: "gitcha2@gitcha.com"}
+		commits := []reporeader.Commit{
+			{Author: author, Message: "feat(parser)!: reject trailing commas", Hash: "1111111111"},
+			{Author: author, CoAuthors: []reporeader.Author{coAuthor}, Message: "feat: add cli\n\nDetails.", Hash: "2222222222"},
+			{Author: author, Message: "fix(parser): handle empty input", Hash: "3333333333"},
+			{Author: author, Message: "docs: update readme", Hash: "4444444444"},
+			{Author: author, Message: "Tidy things up\n\nMore details.", Hash: "5555555555"},
+		}
+
+		actual := changelog.New(commits)
+
+		assert.Equal(t, []changelog.Entry{
+			{Scope: "parser", Description: "reject trailing commas", Authors: []string{"Gitcha One"}, Hash: "1111111111"},
+		}, actual.Breaking)
+		assert.Equal(t, []changelog.Entry{
+			{Description: "add cli", Authors: []string{"Gitcha One", "Gitcha Two"}, Hash: "2222222222"},
+		}, actual.Features)
+		assert.Equal(t, []changelog.Entry{
+			{Scope: "parser", Description: "handle empty input", Authors: []string{"Gitcha One"}, Hash: "3333333333"},
+		}, actual.Fixes)
+		assert.Equal(t, []changelog.Entry{
+			{Description: "update readme", Authors: []string{"Gitcha One"}, Hash: "4444444444"},
+			{Description: "Tidy things up", Authors: []string{"Gitcha One"}, Hash: "5555555555"},
+		}, actual.Others)
+	})
+}
+
+func TestChangelog_Markdown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given entries should render non-empty groups with scopes, authors and short hashes", func(t *testing.T) {
+		t.Parallel()
+
+		log := changelog.Changelog{
+			Features: []changelog.Entry{
+				{Scope: "parser", Description: "add arrays", Authors: []string{"Gitcha One", "Gitcha Two"}, Hash: "1234567890"},
+			},
+			Fixes: []changelog.Entry{{Description: "handle empty input", Authors: []string{"Gitcha One"}, Hash: "abc"}},
+		}
+
+		expected := "# Changelog v1..v2\n" +
+			"\n## Features\n\n" +
+			"- **parser:** add arrays (Gitcha One, Gitcha Two, 1234567)\n" +
+			"\n## Fixes\n\n" +
+			"- handle empty input (Gitcha One, abc)\n"
+
+		assert.Equal(t, expected, log.Markdown("Changelog v1..v2"))
+	})
+
+	t.Run("given no entries should render no changes", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "# Changelog\n\nNo changes.\n", changelog.Changelog{}.Markdown("Changelog"))
+	})
+}
@@ -0,0 +1,52 @@
+package clipboard
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aymanbagabas/go-osc52/v2"
+)
+
+// Clipboard copies text to the clipboard of the terminal by writing an OSC 52 escape sequence to Output. The terminal,
+// rather than the machine gitcha runs on, owns the clipboard so copying works over SSH. Inside tmux and screen the
+// sequence is wrapped to be passed through to the outer terminal, which requires allow-passthrough in tmux.
+type Clipboard struct {
+	// Output is the terminal the sequence is written to. The standard output is used when it is nil.
+	Output io.Writer
+	// Getenv looks up the environment variables identifying tmux and screen. os.Getenv is used when it is nil.
+	Getenv func(key string) string
+}
+
+// Copy copies text to the clipboard of the terminal.
+func (c Clipboard) Copy(text string) error {
+	output := c.Output
+	if output == nil {
+		output = os.Stdout
+	}
+
+	if _, err := c.sequence(text).WriteTo(output); err != nil {
+		return fmt.Errorf("Copy: unable to write to the terminal: %w", err)
+	}
+
+	return nil
+}
+
+// sequence returns the OSC 52 sequence setting the clipboard to text, escaped for the terminal multiplexer gitcha runs
+// in, if any.
+func (c Clipboard) sequence(text string) osc52.Sequence {
+	getenv := c.Getenv
+	if getenv == nil {
+		getenv = os.Getenv
+	}
+
+	sequence := osc52.New(text)
+	switch {
+	case getenv("TMUX") != "":
+		return sequence.Tmux()
+	case getenv("STY") != "":
+		return sequence.Screen()
+	default:
+		return sequence
+	}
+}
@@ -0,0 +1,64 @@
+package clipboard_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/djyuhn/gitcha/internal/clipboard"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClipboard_Copy(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		env      map[string]string
+		expected string
+	}{
+		"given terminal should write OSC 52 sequence": {
+			env:      map[string]string{},
+			expected: "\x1b]52;c;Z2l0Y2hh\x07",
+		},
+		"given tmux should write OSC 52 sequence wrapped for passthrough": {
+			env:      map[string]string{"TMUX": "/tmp/tmux-1000/default,1,0"},
+			expected: "\x1bPtmux;\x1b\x1b]52;c;Z2l0Y2hh\x07\x1b\\",
+		},
+		"given screen should write OSC 52 sequence wrapped in DCS": {
+			env:      map[string]string{"STY": "1234.pts-0.host"},
+			expected: "\x1bP\x1b]52;c;Z2l0Y2hh\x07\x1b\\",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			c := clipboard.Clipboard{Output: &buf, Getenv: func(key string) string { return test.env[key] }}
+
+			err := c.Copy("gitcha")
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, buf.String())
+		})
+	}
+
+	t.Run("given failing output should return error", func(t *testing.T) {
+		t.Parallel()
+
+		c := clipboard.Clipboard{Output: failingWriter{}, Getenv: func(string) string { return "" }}
+
+		err := c.Copy("gitcha")
+
+		assert.ErrorContains(t, err, "Copy: unable to write to the terminal")
+	})
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("closed")
+}
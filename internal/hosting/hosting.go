@@ -0,0 +1,204 @@
+package hosting
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Provider is the kind of hosting service a repository is hosted on.
+type Provider string
+
+const (
+	GitHub    Provider = "GitHub"
+	GitLab    Provider = "GitLab"
+	Bitbucket Provider = "Bitbucket"
+	// Generic is any other host. Its web URL is derived from the remote URL but no links are built for it.
+	Generic Provider = "Generic"
+)
+
+// Project is a repository on a hosting service as identified by one of its remote URLs.
+//
+// Owner is the user, organization or group the repository belongs to and may contain several segments for nested
+// groups such as GitLab subgroups.
+type Project struct {
+	Provider Provider `json:"provider"`
+	Host     string   `json:"host"`
+	Owner    string   `json:"owner"`
+	Name     string   `json:"name"`
+}
+
+// Parse identifies the project a remote URL points to. It supports the https, http, ssh and git URL forms as well as
+// the scp-like form user@host:owner/name used by ssh remotes. Local paths and file URLs are not projects.
+func Parse(remoteURL string) (Project, error) {
+	host, path, err := splitURL(strings.TrimSpace(remoteURL))
+	if err != nil {
+		return Project{}, fmt.Errorf("Parse: %w", err)
+	}
+
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	separator := strings.LastIndex(path, "/")
+	if host == "" || separator <= 0 || separator == len(path)-1 {
+		return Project{}, fmt.Errorf("Parse: %q is not a hosted repository URL", remoteURL)
+	}
+
+	return Project{
+		Provider: providerOf(host),
+		Host:     host,
+		Owner:    path[:separator],
+		Name:     path[separator+1:],
+	}, nil
+}
+
+// splitURL returns the host and path of a remote URL. The host keeps its port for the web based schemes only since
+// the port of an ssh or git remote is not the port of its web interface.
+func splitURL(remoteURL string) (string, string, error) {
+	if !strings.Contains(remoteURL, "://") {
+		// The scp-like form has a colon before any slash, such as git@github.com:owner/name.git.
+		colon := strings.Index(remoteURL, ":")
+		if colon <= 0 || strings.Contains(remoteURL[:colon], "/") {
+			return "", "", fmt.Errorf("%q is not a remote URL", remoteURL)
+		}
+		host := remoteURL[:colon]
+		if at := strings.LastIndex(host, "@"); at >= 0 {
+			host = host[at+1:]
+		}
+		return strings.ToLower(host), remoteURL[colon+1:], nil
+	}
+
+	parsed, err := url.Parse(remoteURL)
+	if err != nil {
+		return "", "", fmt.Errorf("%q is not a remote URL: %w", remoteURL, err)
+	}
+
+	switch parsed.Scheme {
+	case "https", "http":
+		return strings.ToLower(parsed.Host), parsed.Path, nil
+	case "ssh", "git", "git+ssh", "ssh+git":
+		return strings.ToLower(parsed.Hostname()), parsed.Path, nil
+	default:
+		return "", "", fmt.Errorf("%q uses the unsupported scheme %q", remoteURL, parsed.Scheme)
+	}
+}
+
+// providerOf recognizes the hosting provider from the host name, including self-hosted instances such as
+// gitlab.example.com.
+func providerOf(host string) Provider {
+	name := host
+	if colon := strings.Index(name, ":"); colon >= 0 {
+		name = name[:colon]
+	}
+
+	for _, label := range strings.Split(name, ".") {
+		switch label {
+		case "github":
+			return GitHub
+		case "gitlab":
+			return GitLab
+		case "bitbucket":
+			return Bitbucket
+		}
+	}
+
+	return Generic
+}
+
+// WebURL returns the address of the project's web page.
+func (p Project) WebURL() string {
+	return fmt.Sprintf("https://%s/%s/%s", p.Host, p.Owner, p.Name)
+}
+
+// CommitURL returns the address of the web page of a commit, or an empty string for generic hosts.
+func (p Project) CommitURL(hash string) string {
+	switch p.Provider {
+	case GitHub:
+		return fmt.Sprintf("%s/commit/%s", p.WebURL(), hash)
+	case GitLab:
+		return fmt.Sprintf("%s/-/commit/%s", p.WebURL(), hash)
+	case Bitbucket:
+		return fmt.Sprintf("%s/commits/%s", p.WebURL(), hash)
+	case Generic:
+	}
+
+	return ""
+}
+
+// FileURL returns the address of the web page of a file at a revision, such as a branch name or commit hash, or an
+// empty string for generic hosts.
+func (p Project) FileURL(revision, path string) string {
+	path = escapePath(strings.TrimPrefix(path, "/"))
+
+	switch p.Provider {
+	case GitHub:
+		return fmt.Sprintf("%s/blob/%s/%s", p.WebURL(), revision, path)
+	case GitLab:
+		return fmt.Sprintf("%s/-/blob/%s/%s", p.WebURL(), revision, path)
+	case Bitbucket:
+		return fmt.Sprintf("%s/src/%s/%s", p.WebURL(), revision, path)
+	case Generic:
+	}
+
+	return ""
+}
+
+// TreeURL returns the address of the web page of a directory at a revision, or an empty string for generic hosts. An
+// empty path is the root directory.
+func (p Project) TreeURL(revision, path string) string {
+	path = escapePath(strings.Trim(path, "/"))
+
+	var treeURL string
+	switch p.Provider {
+	case GitHub:
+		treeURL = fmt.Sprintf("%s/tree/%s/%s", p.WebURL(), revision, path)
+	case GitLab:
+		treeURL = fmt.Sprintf("%s/-/tree/%s/%s", p.WebURL(), revision, path)
+	case Bitbucket:
+		treeURL = fmt.Sprintf("%s/src/%s/%s", p.WebURL(), revision, path)
+	case Generic:
+	}
+
+	return strings.TrimSuffix(treeURL, "/")
+}
+
+// AuthorURL returns the address of the web page listing the commits of an author, identified by email, up to a
+// revision. It returns an empty string for hosts that cannot search commits by author.
+func (p Project) AuthorURL(revision, email string) string {
+	query := url.Values{"author": []string{email}}.Encode()
+
+	switch p.Provider {
+	case GitHub:
+		return fmt.Sprintf("%s/commits/%s?%s", p.WebURL(), revision, query)
+	case GitLab:
+		return fmt.Sprintf("%s/-/commits/%s?%s", p.WebURL(), revision, query)
+	case Bitbucket, Generic:
+	}
+
+	return ""
+}
+
+// TagURL returns the address of the web page of a tag, or an empty string for generic hosts.
+func (p Project) TagURL(tag string) string {
+	tag = url.PathEscape(tag)
+
+	switch p.Provider {
+	case GitHub:
+		return fmt.Sprintf("%s/releases/tag/%s", p.WebURL(), tag)
+	case GitLab:
+		return fmt.Sprintf("%s/-/tags/%s", p.WebURL(), tag)
+	case Bitbucket:
+		return fmt.Sprintf("%s/src/%s", p.WebURL(), tag)
+	case Generic:
+	}
+
+	return ""
+}
+
+// escapePath escapes each segment of a slash separated path for use in a URL.
+func escapePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,131 @@
+package hosting_test
+
+import (
+	"testing"
+
+	"github.com/djyuhn/gitcha/internal/hosting"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		url      string
+		expected hosting.Project
+	}{
+		"given github https url should return github project": {
+			url:      "https://github.com/djyuhn/gitcha.git",
+			expected: hosting.Project{Provider: hosting.GitHub, Host: "github.com", Owner: "djyuhn", Name: "gitcha"},
+		},
+		"given github scp-like ssh url should return github project": {
+			url:      "git@github.com:djyuhn/gitcha.git",
+			expected: hosting.Project{Provider: hosting.GitHub, Host: "github.com", Owner: "djyuhn", Name: "gitcha"},
+		},
+		"given gitlab ssh url with port and subgroup should return gitlab project without port": {
+			url:      "ssh://git@gitlab.com:2222/group/subgroup/gitcha.git",
+			expected: hosting.Project{Provider: hosting.GitLab, Host: "gitlab.com", Owner: "group/subgroup", Name: "gitcha"},
+		},
+		"given self-hosted gitlab https url should return gitlab project": {
+			url:      "https://gitlab.example.com/team/gitcha",
+			expected: hosting.Project{Provider: hosting.GitLab, Host: "gitlab.example.com", Owner: "team", Name: "gitcha"},
+		},
+		"given bitbucket https url with user should return bitbucket project": {
+			url:      "https://user@bitbucket.org/team/gitcha.git",
+			expected: hosting.Project{Provider: hosting.Bitbucket, Host: "bitbucket.org", Owner: "team", Name: "gitcha"},
+		},
+		"given other host with port should return generic project keeping the port": {
+			url:      "http://git.example.com:8080/team/gitcha.git/",
+			expected: hosting.Project{Provider: hosting.Generic, Host: "git.example.com:8080", Owner: "team", Name: "gitcha"},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := hosting.Parse(test.url)
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+
+	for _, url := range []string{"/srv/git/gitcha", "../gitcha", "file:///srv/git/gitcha.git", "https://github.com/gitcha", ""} {
+		url := url
+		t.Run("given "+url+" should return error", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := hosting.Parse(url)
+
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestProject_Links(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		provider hosting.Provider
+		commit   string
+		file     string
+		tree     string
+		author   string
+		tag      string
+	}{
+		"given github should return github links": {
+			provider: hosting.GitHub,
+			commit:   "https://example.com/team/gitcha/commit/abc123",
+			file:     "https://example.com/team/gitcha/blob/main/docs/read%20me.md",
+			tree:     "https://example.com/team/gitcha/tree/main/docs",
+			author:   "https://example.com/team/gitcha/commits/main?author=gitcha%2Bone%40gitcha.com",
+			tag:      "https://example.com/team/gitcha/releases/tag/v1.0.0",
+		},
+		"given gitlab should return gitlab links": {
+			provider: hosting.GitLab,
+			commit:   "https://example.com/team/gitcha/-/commit/abc123",
+			file:     "https://example.com/team/gitcha/-/blob/main/docs/read%20me.md",
+			tree:     "https://example.com/team/gitcha/-/tree/main/docs",
+			author:   "https://example.com/team/gitcha/-/commits/main?author=gitcha%2Bone%40gitcha.com",
+			tag:      "https://example.com/team/gitcha/-/tags/v1.0.0",
+		},
+		"given bitbucket should return bitbucket links": {
+			provider: hosting.Bitbucket,
+			commit:   "https://example.com/team/gitcha/commits/abc123",
+			file:     "https://example.com/team/gitcha/src/main/docs/read%20me.md",
+			tree:     "https://example.com/team/gitcha/src/main/docs",
+			tag:      "https://example.com/team/gitcha/src/v1.0.0",
+		},
+		"given generic host should return no links": {
+			provider: hosting.Generic,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			project := hosting.Project{Provider: test.provider, Host: "example.com", Owner: "team", Name: "gitcha"}
+
+			assert.Equal(t, "https://example.com/team/gitcha", project.WebURL())
+			assert.Equal(t, test.commit, project.CommitURL("abc123"))
+			assert.Equal(t, test.file, project.FileURL("main", "docs/read me.md"))
+			assert.Equal(t, test.tree, project.TreeURL("main", "docs/"))
+			assert.Equal(t, test.author, project.AuthorURL("main", "gitcha+one@gitcha.com"))
+			assert.Equal(t, test.tag, project.TagURL("v1.0.0"))
+		})
+	}
+
+	t.Run("given root directory should return tree URL without trailing slash", func(t *testing.T) {
+		t.Parallel()
+
+		project := hosting.Project{Provider: hosting.GitHub, Host: "github.com", Owner: "djyuhn", Name: "gitcha"}
+
+		assert.Equal(t, "https://github.com/djyuhn/gitcha/tree/main", project.TreeURL("main", ""))
+	})
+}
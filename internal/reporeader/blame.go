@@ -0,0 +1,346 @@
+package reporeader
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/object/commitgraph"
+	"github.com/go-git/go-git/v5/utils/diff"
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+// binarySniffLength is the number of leading bytes checked for a NUL byte to detect binary content, the same
+// heuristic git uses.
+const binarySniffLength = 8000
+
+// FileAuthorship holds the number of surviving lines of a file attributed to each author by email.
+type FileAuthorship map[string]int
+
+// fileLines holds the author email of every line of a text file at a commit.
+type fileLines struct {
+	blob    plumbing.Hash
+	authors []string
+}
+
+// blameSnapshot holds the line authors of every text file at a commit keyed by path.
+type blameSnapshot map[string]*fileLines
+
+// getFileAuthorship attributes every line of the text files at the newest commit of history to the author of the
+// commit that last changed it.
+//
+// Rather than blaming files one at a time, the history is replayed once from the root commits forward. Each commit
+// only recomputes the files it changed by diffing them against the same file in every parent, so a line keeps the
+// author of the first parent it is unchanged from, just as git blame passes blame to parents. Files renamed or copied
+// since the first parent are diffed against the file they originate from. Trees and parents are read from the commit
+// graph, and only the commits changing a text file are inflated for their author.
+func (r *RepoReader) getFileAuthorship(history *commitHistory) (map[string]FileAuthorship, error) {
+	authorship := make(map[string]FileAuthorship)
+	if len(history.nodes) == 0 {
+		return authorship, nil
+	}
+
+	ordered := topologicalOrder(history.nodes)
+
+	// Snapshots are dropped as soon as every child has been replayed to keep memory bounded by the width of the
+	// history rather than its length.
+	remainingChildren := make(map[plumbing.Hash]int, len(ordered))
+	for _, node := range ordered {
+		for _, parent := range node.ParentHashes() {
+			remainingChildren[parent]++
+		}
+	}
+
+	// Lines last changed by bots left out of the analysis are not attributed to anyone.
+	botEmails := make(map[string]bool)
+
+	snapshots := make(map[plumbing.Hash]blameSnapshot)
+	for _, node := range ordered {
+		snapshot, err := r.blameCommit(history, node, snapshots, remainingChildren, botEmails)
+		if err != nil {
+			return nil, fmt.Errorf("getFileAuthorship: %w", err)
+		}
+		snapshots[node.ID()] = snapshot
+
+		for _, parent := range node.ParentHashes() {
+			remainingChildren[parent]--
+			if remainingChildren[parent] <= 0 {
+				delete(snapshots, parent)
+			}
+		}
+	}
+
+	for path, lines := range snapshots[history.nodes[0].ID()] {
+		fileAuthorship := make(FileAuthorship)
+		for _, author := range lines.authors {
+			if botEmails[author] {
+				continue
+			}
+			fileAuthorship[author]++
+		}
+		if len(fileAuthorship) == 0 {
+			continue
+		}
+		authorship[path] = fileAuthorship
+	}
+
+	return authorship, nil
+}
+
+// blameCommit returns the snapshot of node given the snapshots of its parents. The commit is inflated for its author
+// the first time one of its changes is blamed, recording the author in botEmails when it is a bot left out of the
+// analysis.
+//
+// The snapshot of the first parent is updated in place when node is the last of its children left to replay, since
+// it is dropped right after, so that a linear history shares a single snapshot instead of copying it at every commit.
+func (r *RepoReader) blameCommit(history *commitHistory, node commitgraph.CommitNode, snapshots map[plumbing.Hash]blameSnapshot, remainingChildren map[plumbing.Hash]int, botEmails map[string]bool) (blameSnapshot, error) {
+	tree, err := node.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read tree of commit %s: %w", node.ID(), err)
+	}
+
+	parents := make([]blameSnapshot, 0, node.NumParents())
+	var firstParent plumbing.Hash
+	for _, parent := range node.ParentHashes() {
+		if snapshot, ok := snapshots[parent]; ok {
+			if len(parents) == 0 {
+				firstParent = parent
+			}
+			parents = append(parents, snapshot)
+		}
+	}
+
+	snapshot := make(blameSnapshot)
+	var parentTree *object.Tree
+
+	if len(parents) > 0 {
+		// Unchanged files keep the line authors of the first parent.
+		if remainingChildren[firstParent] == 1 {
+			snapshot = parents[0]
+		} else {
+			for path, lines := range parents[0] {
+				snapshot[path] = lines
+			}
+		}
+
+		parentTree, err = getParentTree(node, 0)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	changes, err := r.diffTrees(parentTree, tree)
+	if err != nil {
+		return nil, fmt.Errorf("unable to diff tree of commit %s: %w", node.ID(), err)
+	}
+
+	// Every change is blamed before the snapshot is updated, so that the files renamed away are still found in the
+	// snapshot of the first parent when it is the one updated.
+	removed := make([]string, 0)
+	blamed := make(map[string]*fileLines)
+	author := ""
+	for _, change := range changes {
+		if change.From.Name != "" && !change.copied {
+			removed = append(removed, change.From.Name)
+		}
+		if change.To.Name == "" || change.To.TreeEntry.Mode == filemode.Submodule {
+			continue
+		}
+
+		if author == "" {
+			commit, err := history.commit(node)
+			if err != nil {
+				return nil, err
+			}
+			author = commit.Author.Email
+			if r.excludesBot(commit.Author.Name, commit.Author.Email) {
+				botEmails[author] = true
+			}
+		}
+
+		lines, err := r.blameFile(change.To.Name, change.sourceName(), change.To.TreeEntry.Hash, author, parents)
+		if err != nil {
+			return nil, fmt.Errorf("unable to blame %s at commit %s: %w", change.To.Name, node.ID(), err)
+		}
+		if lines != nil {
+			blamed[change.To.Name] = lines
+		}
+	}
+
+	for _, path := range removed {
+		delete(snapshot, path)
+	}
+	for path, lines := range blamed {
+		snapshot[path] = lines
+	}
+
+	return snapshot, nil
+}
+
+// blameFile attributes the lines of the blob at path to the parents the lines are unchanged from, falling back to
+// author for new lines. Lines are looked up in the first parent at source, the path the file was renamed or copied
+// from, and at path in the other parents. A nil fileLines is returned for binary content.
+func (r *RepoReader) blameFile(path, source string, blob plumbing.Hash, author string, parents []blameSnapshot) (*fileLines, error) {
+	content, isBinary, err := r.readBlob(blob)
+	if err != nil {
+		return nil, err
+	}
+	if isBinary {
+		return nil, nil
+	}
+
+	authors := make([]string, countLines(content))
+
+	for i, parent := range parents {
+		parentPath := path
+		if i == 0 {
+			parentPath = source
+		}
+
+		parentLines, ok := parent[parentPath]
+		if !ok {
+			continue
+		}
+
+		parentContent, _, err := r.readBlob(parentLines.blob)
+		if err != nil {
+			return nil, err
+		}
+
+		assignUnchangedLines(authors, parentLines.authors, diff.Do(parentContent, content))
+	}
+
+	for i := range authors {
+		if authors[i] == "" {
+			authors[i] = author
+		}
+	}
+
+	return &fileLines{blob: blob, authors: authors}, nil
+}
+
+// assignUnchangedLines copies the parent authors of lines the diffs leave unchanged into authors, keeping lines that
+// were already attributed to an earlier parent.
+func assignUnchangedLines(authors, parentAuthors []string, diffs []diffmatchpatch.Diff) {
+	parentIndex, index := 0, 0
+
+	for _, d := range diffs {
+		count := countLines(d.Text)
+
+		switch d.Type {
+		case diffmatchpatch.DiffEqual:
+			for i := 0; i < count && index+i < len(authors) && parentIndex+i < len(parentAuthors); i++ {
+				if authors[index+i] == "" {
+					authors[index+i] = parentAuthors[parentIndex+i]
+				}
+			}
+			parentIndex += count
+			index += count
+		case diffmatchpatch.DiffDelete:
+			parentIndex += count
+		case diffmatchpatch.DiffInsert:
+			index += count
+		}
+	}
+}
+
+// readBlob returns the content of the blob and whether it is binary.
+func (r *RepoReader) readBlob(hash plumbing.Hash) (string, bool, error) {
+	blob, err := r.repository.BlobObject(hash)
+	if err != nil {
+		return "", false, fmt.Errorf("unable to find blob %s: %w", hash, err)
+	}
+
+	reader, err := blob.Reader()
+	if err != nil {
+		return "", false, fmt.Errorf("unable to open blob %s: %w", hash, err)
+	}
+	defer reader.Close()
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return "", false, fmt.Errorf("unable to read blob %s: %w", hash, err)
+	}
+
+	sniff := content
+	if len(sniff) > binarySniffLength {
+		sniff = sniff[:binarySniffLength]
+	}
+
+	return string(content), bytes.IndexByte(sniff, 0) != -1, nil
+}
+
+// getParentTree returns the tree of the parent of node at index i.
+func getParentTree(node commitgraph.CommitNode, i int) (*object.Tree, error) {
+	parent, err := node.ParentNode(i)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find parent %d of commit %s: %w", i, node.ID(), err)
+	}
+
+	tree, err := parent.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read tree of commit %s: %w", parent.ID(), err)
+	}
+
+	return tree, nil
+}
+
+// countLines returns the number of lines of text, counting a trailing line without a newline.
+func countLines(text string) int {
+	count := strings.Count(text, "\n")
+	if text != "" && !strings.HasSuffix(text, "\n") {
+		count++
+	}
+
+	return count
+}
+
+// topologicalOrder returns nodes ordered so that every commit comes after its parents.
+func topologicalOrder(nodes []commitgraph.CommitNode) []commitgraph.CommitNode {
+	byHash := make(map[plumbing.Hash]commitgraph.CommitNode, len(nodes))
+	for _, node := range nodes {
+		byHash[node.ID()] = node
+	}
+
+	ordered := make([]commitgraph.CommitNode, 0, len(nodes))
+	visited := make(map[plumbing.Hash]bool, len(nodes))
+
+	type frame struct {
+		node    commitgraph.CommitNode
+		parents []plumbing.Hash
+		parent  int
+	}
+
+	// Iterative post-order depth-first traversal so that deep histories do not exhaust the stack.
+	for _, start := range nodes {
+		if visited[start.ID()] {
+			continue
+		}
+		visited[start.ID()] = true
+
+		stack := []frame{{node: start, parents: start.ParentHashes()}}
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			if top.parent < len(top.parents) {
+				parentHash := top.parents[top.parent]
+				top.parent++
+
+				parent, ok := byHash[parentHash]
+				if ok && !visited[parentHash] {
+					visited[parentHash] = true
+					stack = append(stack, frame{node: parent, parents: parent.ParentHashes()})
+				}
+				continue
+			}
+
+			ordered = append(ordered, top.node)
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	return ordered
+}
@@ -0,0 +1,87 @@
+package reporeader
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// noreplyLocalParts are the local parts of email addresses used by automation accounts that do not accept replies.
+var noreplyLocalParts = []string{"noreply", "no-reply", "donotreply", "do-not-reply"}
+
+// isBot returns whether the identity belongs to a bot or automation account.
+//
+// Identities are bots when the name or email carries a [bot] marker, such as dependabot[bot], when the local part of
+// the email is a noreply address, or when the name or email matches one of the configured patterns ignoring case.
+// Patterns may use the wildcards of path.Match. GitHub private addresses of users like
+// 12345+octocat@users.noreply.github.com are not treated as bots.
+func (r *RepoReader) isBot(name, email string) bool {
+	name = strings.ToLower(name)
+	email = strings.ToLower(email)
+
+	if strings.HasSuffix(name, "[bot]") || strings.Contains(email, "[bot]@") {
+		return true
+	}
+
+	localPart, _, _ := strings.Cut(email, "@")
+	for _, noreply := range noreplyLocalParts {
+		if localPart == noreply {
+			return true
+		}
+	}
+
+	for _, pattern := range r.botPatterns {
+		pattern = strings.ToLower(pattern)
+		if matchesBotPattern(pattern, name) || matchesBotPattern(pattern, email) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesBotPattern returns whether value matches pattern, treating malformed patterns as literals.
+func matchesBotPattern(pattern, value string) bool {
+	matched, err := path.Match(pattern, value)
+	if err != nil {
+		return pattern == value
+	}
+
+	return matched
+}
+
+// excludesBot returns whether the identity is a bot whose activity is left out of the analysis.
+func (r *RepoReader) excludesBot(name, email string) bool {
+	return r.botMode != BotsInclude && r.isBot(name, email)
+}
+
+// getBotCommits groups the commits authored by bots by email when bots are grouped separately.
+func (r *RepoReader) getBotCommits(commits []*object.Commit) map[string][]Commit {
+	botCommits := make(map[string][]Commit)
+	if r.botMode != BotsSeparate {
+		return botCommits
+	}
+
+	for _, commit := range commits {
+		if !r.isBot(commit.Author.Name, commit.Author.Email) {
+			continue
+		}
+
+		botCommits[commit.Author.Email] = append(botCommits[commit.Author.Email], r.newCommit(commit))
+	}
+
+	return botCommits
+}
+
+// GetBotCommits returns the commits authored by bots grouped by email. Commits are only returned when the RepoReader
+// groups bots separately.
+func (r *RepoReader) GetBotCommits() (map[string][]Commit, error) {
+	commits, err := r.getCommits()
+	if err != nil {
+		return nil, fmt.Errorf("GetBotCommits: unable to get the repository commits: %w", err)
+	}
+
+	return r.getBotCommits(commits), nil
+}
@@ -0,0 +1,122 @@
+package reporeader_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/djyuhn/gitcha/gittest"
+	"github.com/djyuhn/gitcha/internal/reporeader"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoReader_Bots(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+	human := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: start}
+	privateHuman := object.Signature{Name: "Octocat", Email: "12345+octocat@users.noreply.github.com", When: start.Add(time.Hour)}
+	dependabot := object.Signature{
+		Name:  "dependabot[bot]",
+		Email: "49699333+dependabot[bot]@users.noreply.github.com",
+		When:  start.Add(2 * time.Hour),
+	}
+	ci := object.Signature{Name: "Release Pipeline", Email: "ci-release@gitcha.com", When: start.Add(3 * time.Hour)}
+	noreply := object.Signature{Name: "GitHub", Email: "noreply@github.com", When: start.Add(4 * time.Hour)}
+
+	createRepo := func(t *testing.T) *git.Repository {
+		t.Helper()
+
+		commits := []gittest.LocalCommit{
+			{Author: human, Message: "Add app", Files: map[string]string{"app.go": "one\ntwo\n"}},
+			{Author: privateHuman, Message: "Add readme", Files: map[string]string{"README.md": "readme\n"}},
+			{Author: dependabot, Message: "Bump deps", Files: map[string]string{"go.mod": "module gitcha\n"}},
+			{Author: ci, Message: "Release", Files: map[string]string{"app.go": "one\ntwo\nthree\n"}},
+			{Author: noreply, Message: "Merge pull request", Files: map[string]string{"app.go": "one\ntwo\nthree\nfour\n"}},
+		}
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		return repo
+	}
+
+	t.Run("given bots are included should credit bots like any other author", func(t *testing.T) {
+		t.Parallel()
+
+		repoReader, err := reporeader.NewRepoReaderRepository(createRepo(t))
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetRepoDetails()
+		require.NoError(t, err)
+
+		assert.Len(t, actual.AuthorsCommits, 5)
+		assert.Empty(t, actual.BotCommits)
+	})
+
+	t.Run("given bots are excluded should leave known bots and configured patterns out of every stat", func(t *testing.T) {
+		t.Parallel()
+
+		repoReader, err := reporeader.NewRepoReaderRepository(createRepo(t),
+			reporeader.WithBotMode(reporeader.BotsExclude), reporeader.WithBotPatterns("CI-*@gitcha.com"),
+			reporeader.WithAnalyses(reporeader.Analyses...))
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetRepoDetails()
+		require.NoError(t, err)
+
+		assert.Len(t, actual.AuthorsCommits, 2)
+		assert.Contains(t, actual.AuthorsCommits, human.Email)
+		assert.Contains(t, actual.AuthorsCommits, privateHuman.Email)
+		assert.Empty(t, actual.BotCommits)
+		assert.Len(t, actual.Timezones.Repository.Offsets(), 1)
+		assert.Equal(t, 2, actual.Timezones.Repository[0])
+
+		assert.Equal(t, 3, actual.Ownership.Repository.Lines)
+		for _, history := range actual.FileHistories {
+			for _, change := range history.Changes {
+				assert.NotEqual(t, dependabot.Email, change.Author)
+				assert.NotEqual(t, ci.Email, change.Author)
+			}
+		}
+	})
+
+	t.Run("given bots are grouped separately should return bot commits apart from the authors", func(t *testing.T) {
+		t.Parallel()
+
+		repoReader, err := reporeader.NewRepoReaderRepository(createRepo(t), reporeader.WithBotMode(reporeader.BotsSeparate))
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetRepoDetails()
+		require.NoError(t, err)
+
+		assert.Len(t, actual.AuthorsCommits, 3)
+		assert.Contains(t, actual.AuthorsCommits, ci.Email)
+		assert.Len(t, actual.BotCommits, 2)
+		assert.Len(t, actual.BotCommits[dependabot.Email], 1)
+		assert.Len(t, actual.BotCommits[noreply.Email], 1)
+	})
+}
+
+func TestParseBotMode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given supported mode should return it", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := reporeader.ParseBotMode("separate")
+
+		assert.NoError(t, err)
+		assert.Equal(t, reporeader.BotsSeparate, actual)
+	})
+
+	t.Run("given unsupported mode should return error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := reporeader.ParseBotMode("hide")
+
+		assert.Error(t, err)
+	})
+}
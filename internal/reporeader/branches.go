@@ -0,0 +1,277 @@
+package reporeader
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object/commitgraph"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+// DefaultStaleAfter is how long a branch can go without commits, relative to the most recent commit on any branch,
+// before it is considered stale.
+const DefaultStaleAfter = 90 * 24 * time.Hour
+
+// defaultBranchCandidates are the local branches tried in order when the default branch is not set by origin/HEAD.
+var defaultBranchCandidates = []plumbing.ReferenceName{
+	plumbing.NewBranchReferenceName("main"),
+	plumbing.NewBranchReferenceName("master"),
+}
+
+// Branch is a local or remote-tracking branch compared with the default branch.
+//
+// Ahead counts the commits on the branch that are not on the default branch and Behind the commits on the default
+// branch that are not on the branch. The remote-tracking branch of origin with the name of the default branch is a
+// default branch too, its copy on the remote rather than a branch to merge into it. A branch is merged when it has no commits ahead of the default branch and stale
+// when its last commit is older than the stale threshold.
+type Branch struct {
+	Name           string    `json:"name"`
+	Remote         bool      `json:"remote"`
+	Default        bool      `json:"default"`
+	Hash           string    `json:"hash"`
+	LastCommitDate time.Time `json:"lastCommitDate"`
+	LastAuthor     Author    `json:"lastAuthor"`
+	Ahead          int       `json:"ahead"`
+	Behind         int       `json:"behind"`
+	Merged         bool      `json:"merged"`
+	Stale          bool      `json:"stale"`
+}
+
+// Branches holds the branches of the repository ordered by name along with the name of the default branch they are
+// compared with.
+type Branches struct {
+	Default  string   `json:"default"`
+	Branches []Branch `json:"branches"`
+}
+
+// defaultHistory is the history of the default branch indexed for repeated reachability queries.
+type defaultHistory struct {
+	positions map[plumbing.Hash]int
+	parents   [][]int
+	// visited holds, for every commit, the last query that reached it so that it does not need to be cleared.
+	visited []int
+	query   int
+}
+
+// getBranches compares every local and remote-tracking branch with the default branch.
+func (r *RepoReader) getBranches() (Branches, error) {
+	defaultRef, err := r.getDefaultBranch()
+	if err != nil {
+		return Branches{}, fmt.Errorf("getBranches: %w", err)
+	}
+
+	branches := Branches{Default: defaultRef.Name().Short(), Branches: make([]Branch, 0)}
+	defaultName := defaultRef.Name().Short()
+	if defaultRef.Name().IsRemote() {
+		defaultName = remoteBranchName(defaultRef.Name())
+	}
+	defaultNames := map[plumbing.ReferenceName]bool{
+		defaultRef.Name(): true,
+		plumbing.NewRemoteReferenceName(originRemote, defaultName): true,
+	}
+
+	refs, err := r.repository.References()
+	if err != nil {
+		return Branches{}, fmt.Errorf("getBranches: unable to list references: %w", err)
+	}
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		if ref.Type() != plumbing.HashReference || (!ref.Name().IsBranch() && !ref.Name().IsRemote()) {
+			return nil
+		}
+		branches.Branches = append(branches.Branches, Branch{
+			Name:    ref.Name().Short(),
+			Remote:  ref.Name().IsRemote(),
+			Default: defaultNames[ref.Name()],
+			Hash:    ref.Hash().String(),
+		})
+		return nil
+	})
+	if err != nil && err != storer.ErrStop {
+		return Branches{}, fmt.Errorf("getBranches: unable to list branches: %w", err)
+	}
+
+	index, closer := r.getCommitNodeIndex()
+	if closer != nil {
+		defer closer.Close()
+	}
+
+	history, err := newDefaultHistory(index, defaultRef.Hash())
+	if err != nil {
+		return Branches{}, fmt.Errorf("getBranches: %w", err)
+	}
+
+	latest := time.Time{}
+	for i := range branches.Branches {
+		branch := &branches.Branches[i]
+
+		node, err := index.Get(plumbing.NewHash(branch.Hash))
+		if err != nil {
+			return Branches{}, fmt.Errorf("getBranches: unable to find commit %s of %s: %w", branch.Hash, branch.Name, err)
+		}
+		commit, err := node.Commit()
+		if err != nil {
+			return Branches{}, fmt.Errorf("getBranches: unable to read commit %s of %s: %w", branch.Hash, branch.Name, err)
+		}
+
+		branch.LastCommitDate = commit.Committer.When
+		branch.LastAuthor = Author{Name: commit.Author.Name, Email: commit.Author.Email}
+		if branch.LastCommitDate.After(latest) {
+			latest = branch.LastCommitDate
+		}
+
+		branch.Ahead, branch.Behind, err = history.compare(index, node)
+		if err != nil {
+			return Branches{}, fmt.Errorf("getBranches: unable to compare %s: %w", branch.Name, err)
+		}
+		branch.Merged = !branch.Default && branch.Ahead == 0
+	}
+
+	for i := range branches.Branches {
+		branch := &branches.Branches[i]
+		branch.Stale = !branch.Default && r.staleAfter > 0 && latest.Sub(branch.LastCommitDate) > r.staleAfter
+	}
+
+	sort.Slice(branches.Branches, func(i, j int) bool {
+		if branches.Branches[i].Remote != branches.Branches[j].Remote {
+			return !branches.Branches[i].Remote
+		}
+		return branches.Branches[i].Name < branches.Branches[j].Name
+	})
+
+	return branches, nil
+}
+
+// getDefaultBranch returns the branch the others are compared with: the local branch matching the target of
+// origin/HEAD, or origin/HEAD's target itself, then main, master and finally HEAD.
+func (r *RepoReader) getDefaultBranch() (*plumbing.Reference, error) {
+	originHead, err := r.repository.Reference(plumbing.NewRemoteHEADReferenceName("origin"), false)
+	if err == nil && originHead.Type() == plumbing.SymbolicReference {
+		target, err := r.repository.Reference(originHead.Target(), true)
+		if err == nil {
+			local, err := r.repository.Reference(plumbing.NewBranchReferenceName(remoteBranchName(target.Name())), true)
+			if err == nil {
+				return local, nil
+			}
+			return target, nil
+		}
+	}
+
+	for _, candidate := range defaultBranchCandidates {
+		if ref, err := r.repository.Reference(candidate, true); err == nil {
+			return ref, nil
+		}
+	}
+
+	head, err := r.repository.Head()
+	if err != nil {
+		return nil, fmt.Errorf("getDefaultBranch: unable to get the repository head: %w", err)
+	}
+
+	return head, nil
+}
+
+// remoteBranchName returns the name of a remote-tracking branch without its remote, such as main for
+// refs/remotes/origin/main.
+func remoteBranchName(name plumbing.ReferenceName) string {
+	short := name.Short()
+	for i := 0; i < len(short); i++ {
+		if short[i] == '/' {
+			return short[i+1:]
+		}
+	}
+
+	return short
+}
+
+// newDefaultHistory indexes the commits reachable from the tip of the default branch.
+func newDefaultHistory(index commitgraph.CommitNodeIndex, tip plumbing.Hash) (*defaultHistory, error) {
+	history := &defaultHistory{positions: make(map[plumbing.Hash]int)}
+
+	node, err := index.Get(tip)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find commit %s of the default branch: %w", tip, err)
+	}
+
+	parentHashes := make([][]plumbing.Hash, 0)
+	err = commitgraph.NewCommitNodeIterCTime(node, nil, nil).ForEach(func(n commitgraph.CommitNode) error {
+		history.positions[n.ID()] = len(parentHashes)
+		parentHashes = append(parentHashes, n.ParentHashes())
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to walk the default branch: %w", err)
+	}
+
+	history.parents = make([][]int, len(parentHashes))
+	for i, hashes := range parentHashes {
+		for _, hash := range hashes {
+			if position, ok := history.positions[hash]; ok {
+				history.parents[i] = append(history.parents[i], position)
+			}
+		}
+	}
+	history.visited = make([]int, len(parentHashes))
+
+	return history, nil
+}
+
+// compare returns the number of commits reachable from tip but not from the default branch, and the number of commits
+// reachable from the default branch but not from tip.
+//
+// Commits off the default branch are walked until they join its history, then only the indexed history is walked to
+// count the commits of the default branch tip can reach.
+func (h *defaultHistory) compare(index commitgraph.CommitNodeIndex, tip commitgraph.CommitNode) (int, int, error) {
+	h.query++
+
+	ahead := 0
+	boundary := make([]int, 0)
+	seen := make(map[plumbing.Hash]bool)
+	stack := []plumbing.Hash{tip.ID()}
+
+	for len(stack) > 0 {
+		hash := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if seen[hash] {
+			continue
+		}
+		seen[hash] = true
+
+		if position, ok := h.positions[hash]; ok {
+			boundary = append(boundary, position)
+			continue
+		}
+
+		ahead++
+		node, err := index.Get(hash)
+		if err != nil {
+			return 0, 0, fmt.Errorf("unable to find commit %s: %w", hash, err)
+		}
+		stack = append(stack, node.ParentHashes()...)
+	}
+
+	reachable := 0
+	for len(boundary) > 0 {
+		position := boundary[len(boundary)-1]
+		boundary = boundary[:len(boundary)-1]
+		if h.visited[position] == h.query {
+			continue
+		}
+		h.visited[position] = h.query
+		reachable++
+		boundary = append(boundary, h.parents[position]...)
+	}
+
+	return ahead, len(h.positions) - reachable, nil
+}
+
+// GetBranches returns the local and remote-tracking branches of the repository compared with the default branch.
+func (r *RepoReader) GetBranches() (Branches, error) {
+	branches, err := r.getBranches()
+	if err != nil {
+		return Branches{}, fmt.Errorf("GetBranches: %w", err)
+	}
+
+	return branches, nil
+}
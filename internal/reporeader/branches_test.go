@@ -0,0 +1,194 @@
+package reporeader_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/djyuhn/gitcha/gittest"
+	"github.com/djyuhn/gitcha/internal/reporeader"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoReader_GetBranches(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+	authorOne := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: start}
+	authorTwo := object.Signature{Name: "Gitcha Two", Email: "gitcha2@gitcha.com", When: start.Add(time.Hour)}
+	day := 24 * time.Hour
+
+	// master: First - Second - Third, feature branches off Second, old branches off First and merged points at Second.
+	commits := []gittest.LocalCommit{
+		{Author: authorOne, Message: "First", Files: map[string]string{"a.txt": "a\n"}},
+		{
+			Author:  object.Signature{Name: authorOne.Name, Email: authorOne.Email, When: start.Add(day)},
+			Message: "Second",
+			Files:   map[string]string{"b.txt": "b\n"},
+		},
+		{
+			Author:  object.Signature{Name: authorTwo.Name, Email: authorTwo.Email, When: start.Add(95 * day)},
+			Message: "Feature",
+			Files:   map[string]string{"feature.txt": "feature\n"},
+			Parents: []int{1},
+		},
+		{Author: authorTwo, Message: "Old", Files: map[string]string{"old.txt": "old\n"}, Parents: []int{0}},
+		{
+			Author:  object.Signature{Name: authorOne.Name, Email: authorOne.Email, When: start.Add(100 * day)},
+			Message: "Third",
+			Files:   map[string]string{"c.txt": "c\n"},
+			Parents: []int{1},
+		},
+	}
+
+	createRepo := func(t *testing.T) (*git.Repository, map[string]plumbing.Hash) {
+		t.Helper()
+
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		hashes := make(map[string]plumbing.Hash)
+		iter, err := repo.CommitObjects()
+		require.NoError(t, err)
+		require.NoError(t, iter.ForEach(func(c *object.Commit) error {
+			hashes[c.Message] = c.Hash
+			return nil
+		}))
+
+		for name, message := range map[string]string{"feature": "Feature", "old": "Old", "merged": "Second"} {
+			ref := plumbing.NewHashReference(plumbing.NewBranchReferenceName(name), hashes[message])
+			require.NoError(t, repo.Storer.SetReference(ref))
+		}
+		ref := plumbing.NewHashReference(plumbing.NewRemoteReferenceName("origin", "feature"), hashes["Feature"])
+		require.NoError(t, repo.Storer.SetReference(ref))
+
+		return repo, hashes
+	}
+
+	t.Run("given branches should compare them with the default branch and flag merged and stale branches", func(t *testing.T) {
+		t.Parallel()
+
+		repo, hashes := createRepo(t)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetBranches()
+		require.NoError(t, err)
+
+		assert.Equal(t, "master", actual.Default)
+
+		expected := []reporeader.Branch{
+			{
+				Name: "feature", Hash: hashes["Feature"].String(), LastCommitDate: start.Add(95 * day),
+				LastAuthor: reporeader.Author{Name: authorTwo.Name, Email: authorTwo.Email}, Ahead: 1, Behind: 1,
+			},
+			{
+				Name: "master", Default: true, Hash: hashes["Third"].String(), LastCommitDate: start.Add(100 * day),
+				LastAuthor: reporeader.Author{Name: authorOne.Name, Email: authorOne.Email},
+			},
+			{
+				Name: "merged", Hash: hashes["Second"].String(), LastCommitDate: start.Add(day),
+				LastAuthor: reporeader.Author{Name: authorOne.Name, Email: authorOne.Email}, Behind: 1, Merged: true, Stale: true,
+			},
+			{
+				Name: "old", Hash: hashes["Old"].String(), LastCommitDate: start.Add(time.Hour),
+				LastAuthor: reporeader.Author{Name: authorTwo.Name, Email: authorTwo.Email}, Ahead: 1, Behind: 2, Stale: true,
+			},
+			{
+				Name: "origin/feature", Remote: true, Hash: hashes["Feature"].String(), LastCommitDate: start.Add(95 * day),
+				LastAuthor: reporeader.Author{Name: authorTwo.Name, Email: authorTwo.Email}, Ahead: 1, Behind: 1,
+			},
+		}
+
+		require.Len(t, actual.Branches, len(expected))
+		for i := range expected {
+			assert.True(t, expected[i].LastCommitDate.Equal(actual.Branches[i].LastCommitDate))
+			actual.Branches[i].LastCommitDate = expected[i].LastCommitDate
+		}
+		assert.Equal(t, expected, actual.Branches)
+	})
+
+	t.Run("given origin HEAD should use the local branch it points to as the default branch", func(t *testing.T) {
+		t.Parallel()
+
+		repo, hashes := createRepo(t)
+
+		ref := plumbing.NewHashReference(plumbing.NewRemoteReferenceName("origin", "feature"), hashes["Feature"])
+		require.NoError(t, repo.Storer.SetReference(ref))
+		originHead := plumbing.NewSymbolicReference(plumbing.NewRemoteHEADReferenceName("origin"), ref.Name())
+		require.NoError(t, repo.Storer.SetReference(originHead))
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetBranches()
+		require.NoError(t, err)
+
+		assert.Equal(t, "feature", actual.Default)
+		require.Len(t, actual.Branches, 5)
+
+		master := actual.Branches[1]
+		assert.Equal(t, "master", master.Name)
+		assert.Equal(t, 1, master.Ahead)
+		assert.Equal(t, 1, master.Behind)
+		assert.False(t, master.Default)
+
+		originFeature := actual.Branches[4]
+		assert.Equal(t, "origin/feature", originFeature.Name)
+		assert.True(t, originFeature.Default)
+		assert.False(t, originFeature.Merged)
+	})
+
+	t.Run("given remote-tracking branch of the default branch on origin should flag it as default rather than merged", func(t *testing.T) {
+		t.Parallel()
+
+		repo, hashes := createRepo(t)
+
+		ref := plumbing.NewHashReference(plumbing.NewRemoteReferenceName("origin", "master"), hashes["Second"])
+		require.NoError(t, repo.Storer.SetReference(ref))
+		upstream := plumbing.NewHashReference(plumbing.NewRemoteReferenceName("upstream", "master"), hashes["Second"])
+		require.NoError(t, repo.Storer.SetReference(upstream))
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetBranches()
+		require.NoError(t, err)
+
+		assert.Equal(t, "master", actual.Default)
+		require.Len(t, actual.Branches, 7)
+
+		originMaster := actual.Branches[5]
+		assert.Equal(t, "origin/master", originMaster.Name)
+		assert.True(t, originMaster.Default)
+		assert.Equal(t, 1, originMaster.Behind)
+		assert.False(t, originMaster.Merged)
+		assert.False(t, originMaster.Stale)
+
+		upstreamMaster := actual.Branches[6]
+		assert.Equal(t, "upstream/master", upstreamMaster.Name)
+		assert.False(t, upstreamMaster.Default)
+		assert.True(t, upstreamMaster.Merged)
+	})
+
+	t.Run("given stale after option should only flag branches older than the threshold", func(t *testing.T) {
+		t.Parallel()
+
+		repo, _ := createRepo(t)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo, reporeader.WithStaleAfter(365*day))
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetBranches()
+		require.NoError(t, err)
+
+		for _, branch := range actual.Branches {
+			assert.False(t, branch.Stale, branch.Name)
+		}
+	})
+}
@@ -0,0 +1,148 @@
+package reporeader
+
+import (
+	"fmt"
+	"path"
+	"sort"
+)
+
+// knowledgeableOwnerShare is the share of the surviving lines of a file an author needs to own to be considered
+// knowledgeable about it. The top owner of a file is always considered knowledgeable.
+const knowledgeableOwnerShare = 0.25
+
+// BusFactor holds the minimum number of authors whose departure would leave more than half the files without a
+// knowledgeable owner, along with the files and directories that would be left without one.
+type BusFactor struct {
+	Factor            int      `json:"factor"`
+	Authors           []string `json:"authors"`
+	FileCount         int      `json:"fileCount"`
+	AtRiskFiles       []string `json:"atRiskFiles"`
+	AtRiskDirectories []string `json:"atRiskDirectories"`
+}
+
+// getBusFactor computes the bus factor from the authorship of the surviving lines of each file.
+//
+// Authors are removed greedily, always picking the author who is knowledgeable about the most files that still have
+// an owner, until more than half the files have none left. Directories are at risk when more than half the files
+// beneath them are at risk.
+func (r *RepoReader) getBusFactor(authorship map[string]FileAuthorship) BusFactor {
+	owners := make(map[string]map[string]bool, len(authorship))
+	for file, fileAuthorship := range authorship {
+		owners[file] = knowledgeableOwners(fileAuthorship)
+	}
+
+	busFactor := BusFactor{
+		Authors:           make([]string, 0),
+		FileCount:         len(owners),
+		AtRiskFiles:       make([]string, 0),
+		AtRiskDirectories: make([]string, 0),
+	}
+
+	orphaned := make(map[string]bool)
+	for len(orphaned)*2 <= len(owners) {
+		author, ok := mostKnowledgeableAuthor(owners, orphaned)
+		if !ok {
+			break
+		}
+		busFactor.Authors = append(busFactor.Authors, author)
+
+		for file, fileOwners := range owners {
+			if orphaned[file] || !fileOwners[author] {
+				continue
+			}
+			delete(fileOwners, author)
+			if len(fileOwners) == 0 {
+				orphaned[file] = true
+			}
+		}
+	}
+	busFactor.Factor = len(busFactor.Authors)
+
+	directoryFiles := make(map[string]int)
+	directoryOrphans := make(map[string]int)
+	for file := range owners {
+		for dir := path.Dir(file); dir != "."; dir = path.Dir(dir) {
+			directoryFiles[dir]++
+			if orphaned[file] {
+				directoryOrphans[dir]++
+			}
+		}
+		if orphaned[file] {
+			busFactor.AtRiskFiles = append(busFactor.AtRiskFiles, file)
+		}
+	}
+	for dir, files := range directoryFiles {
+		if directoryOrphans[dir]*2 > files {
+			busFactor.AtRiskDirectories = append(busFactor.AtRiskDirectories, dir)
+		}
+	}
+
+	sort.Strings(busFactor.AtRiskFiles)
+	sort.Strings(busFactor.AtRiskDirectories)
+
+	return busFactor
+}
+
+// knowledgeableOwners returns the authors who own at least knowledgeableOwnerShare of the lines of a file, along with
+// its top owner.
+func knowledgeableOwners(fileAuthorship FileAuthorship) map[string]bool {
+	total := 0
+	for _, lines := range fileAuthorship {
+		total += lines
+	}
+
+	owners := make(map[string]bool)
+	topOwner, topLines := "", 0
+	for author, lines := range fileAuthorship {
+		if float64(lines) >= knowledgeableOwnerShare*float64(total) {
+			owners[author] = true
+		}
+		if lines > topLines || (lines == topLines && author < topOwner) {
+			topOwner, topLines = author, lines
+		}
+	}
+	if topOwner != "" {
+		owners[topOwner] = true
+	}
+
+	return owners
+}
+
+// mostKnowledgeableAuthor returns the author knowledgeable about the most files that are not yet orphaned. Ties are
+// broken by email so that results are stable.
+func mostKnowledgeableAuthor(owners map[string]map[string]bool, orphaned map[string]bool) (string, bool) {
+	fileCounts := make(map[string]int)
+	for file, fileOwners := range owners {
+		if orphaned[file] {
+			continue
+		}
+		for author := range fileOwners {
+			fileCounts[author]++
+		}
+	}
+
+	best, bestCount := "", 0
+	for author, count := range fileCounts {
+		if count > bestCount || (count == bestCount && author < best) {
+			best, bestCount = author, count
+		}
+	}
+
+	return best, bestCount > 0
+}
+
+// GetBusFactor returns the bus factor of the repository based on the authorship of the surviving lines at HEAD.
+func (r *RepoReader) GetBusFactor() (BusFactor, error) {
+	history, err := r.getHistory()
+	if err != nil {
+		return BusFactor{}, fmt.Errorf("GetBusFactor: unable to get the repository history: %w", err)
+	}
+	defer history.close()
+
+	authorship, err := r.getFileAuthorship(history)
+	if err != nil {
+		return BusFactor{}, fmt.Errorf("GetBusFactor: unable to get the file authorship: %w", err)
+	}
+
+	return r.getBusFactor(authorship), nil
+}
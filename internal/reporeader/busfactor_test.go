@@ -0,0 +1,247 @@
+package reporeader_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/djyuhn/gitcha/gittest"
+	"github.com/djyuhn/gitcha/internal/reporeader"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoReader_GetBusFactor(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+	authorOne := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: start}
+	authorTwo := object.Signature{Name: "Gitcha Two", Email: "gitcha2@gitcha.com", When: start.Add(time.Hour)}
+
+	t.Run("given one author owning most files should return bus factor of 1 with their files at risk", func(t *testing.T) {
+		t.Parallel()
+
+		commits := []gittest.LocalCommit{
+			{
+				Author:  authorOne,
+				Message: "Add billing service",
+				Files: map[string]string{
+					"services/billing/invoice.go": "package billing\n",
+					"services/billing/payment.go": "package billing\n",
+				},
+			},
+			{
+				Author:  authorTwo,
+				Message: "Add readme",
+				Files:   map[string]string{"README.md": "# gitcha\n"},
+			},
+		}
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		expected := reporeader.BusFactor{
+			Factor:            1,
+			Authors:           []string{authorOne.Email},
+			FileCount:         3,
+			AtRiskFiles:       []string{"services/billing/invoice.go", "services/billing/payment.go"},
+			AtRiskDirectories: []string{"services", "services/billing"},
+		}
+
+		actual, err := repoReader.GetBusFactor()
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("given file rewritten by another author should credit the surviving lines to the new author", func(t *testing.T) {
+		t.Parallel()
+
+		commits := []gittest.LocalCommit{
+			{
+				Author:  authorOne,
+				Message: "Add files",
+				Files: map[string]string{
+					"a.txt": "one\ntwo\nthree\nfour\n",
+					"b.txt": "one\n",
+				},
+			},
+			{
+				Author:  authorTwo,
+				Message: "Rewrite a",
+				Files:   map[string]string{"a.txt": "five\nsix\nseven\neight\n"},
+			},
+		}
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		// Each author is the only owner of one of the two files so both have to leave to orphan more than half.
+		expected := reporeader.BusFactor{
+			Factor:            2,
+			Authors:           []string{authorOne.Email, authorTwo.Email},
+			FileCount:         2,
+			AtRiskFiles:       []string{"a.txt", "b.txt"},
+			AtRiskDirectories: []string{},
+		}
+
+		actual, err := repoReader.GetBusFactor()
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("given binary file should exclude it from the bus factor", func(t *testing.T) {
+		t.Parallel()
+
+		commits := []gittest.LocalCommit{
+			{
+				Author:  authorOne,
+				Message: "Add image",
+				Files:   map[string]string{"image.png": "\x89PNG\x00\x01"},
+			},
+			{
+				Author:  authorTwo,
+				Message: "Add readme",
+				Files:   map[string]string{"README.md": "# gitcha\n"},
+			},
+		}
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetBusFactor()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, actual.FileCount)
+		assert.Equal(t, []string{authorTwo.Email}, actual.Authors)
+	})
+}
+
+func TestRepoReader_LoadAnalysis(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+	authorOne := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: start}
+	authorTwo := object.Signature{Name: "Gitcha Two", Email: "gitcha2@gitcha.com", When: start.Add(time.Hour)}
+
+	commits := []gittest.LocalCommit{
+		{Author: authorOne, Message: "Add service", Files: map[string]string{"service/main.go": "package main\n"}},
+		{Author: authorTwo, Message: "Add readme", Files: map[string]string{"README.md": "# gitcha\n\nDocs\n"}},
+	}
+
+	t.Run("given default analyses should defer them and leave the bus factor, ownership and file histories empty", func(t *testing.T) {
+		t.Parallel()
+
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetRepoDetails()
+		require.NoError(t, err)
+
+		assert.Equal(t, reporeader.Analyses, actual.Deferred)
+		assert.True(t, actual.IsDeferred(reporeader.AnalysisAuthorship))
+		assert.Equal(t, reporeader.BusFactor{}, actual.BusFactor)
+		assert.Equal(t, reporeader.Ownership{}, actual.Ownership)
+		assert.Empty(t, actual.FileHistories)
+	})
+
+	t.Run("given deferred authorship should load the same bus factor, ownership and tree as running it up front", func(t *testing.T) {
+		t.Parallel()
+
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+		details, err := repoReader.GetRepoDetails()
+		require.NoError(t, err)
+
+		eagerReader, err := reporeader.NewRepoReaderRepository(repo, reporeader.WithAnalyses(reporeader.AnalysisAuthorship))
+		require.NoError(t, err)
+		expected, err := eagerReader.GetRepoDetails()
+		require.NoError(t, err)
+
+		actual, err := repoReader.LoadAnalysis(details, reporeader.AnalysisAuthorship)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected.Deferred, actual.Deferred)
+		assert.Equal(t, expected.BusFactor, actual.BusFactor)
+		assert.Equal(t, expected.Ownership, actual.Ownership)
+		assert.Equal(t, expected.Tree, actual.Tree)
+		assert.Equal(t, 2, actual.BusFactor.FileCount)
+	})
+
+	t.Run("given every analysis loaded in turn should return the same details as running them up front", func(t *testing.T) {
+		t.Parallel()
+
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+		actual, err := repoReader.GetRepoDetails()
+		require.NoError(t, err)
+
+		eagerReader, err := reporeader.NewRepoReaderRepository(repo, reporeader.WithAnalyses(reporeader.Analyses...))
+		require.NoError(t, err)
+		expected, err := eagerReader.GetRepoDetails()
+		require.NoError(t, err)
+
+		for _, analysis := range reporeader.Analyses {
+			actual, err = repoReader.LoadAnalysis(actual, analysis)
+			require.NoError(t, err)
+		}
+
+		assert.Empty(t, actual.Deferred)
+		assert.Equal(t, expected.FileHistories, actual.FileHistories)
+		assert.Equal(t, expected.Ownership, actual.Ownership)
+		assert.Equal(t, expected.Tree, actual.Tree)
+		assert.Equal(t, expected.Size, actual.Size)
+	})
+
+	t.Run("given unsupported analysis should return error", func(t *testing.T) {
+		t.Parallel()
+
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		_, err = repoReader.LoadAnalysis(reporeader.RepoDetails{}, reporeader.Analysis("everything"))
+
+		assert.Error(t, err)
+	})
+}
+
+func TestParseAnalysis(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given supported analysis should return it", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := reporeader.ParseAnalysis("authorship")
+
+		assert.NoError(t, err)
+		assert.Equal(t, reporeader.AnalysisAuthorship, actual)
+	})
+
+	t.Run("given unsupported analysis should return error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := reporeader.ParseAnalysis("everything")
+
+		assert.Error(t, err)
+	})
+}
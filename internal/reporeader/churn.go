@@ -0,0 +1,296 @@
+package reporeader
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/object/commitgraph"
+	"github.com/go-git/go-git/v5/utils/diff"
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+// FileChange is a change made to a file by a single commit.
+type FileChange struct {
+	Hash    string    `json:"hash"`
+	Author  string    `json:"author"`
+	Date    time.Time `json:"date"`
+	Added   int       `json:"added"`
+	Deleted int       `json:"deleted"`
+}
+
+// FileHistory holds the changes made to a file present at HEAD by non-merge commits, newest first.
+type FileHistory struct {
+	Path    string       `json:"path"`
+	Changes []FileChange `json:"changes"`
+}
+
+// FileStats summarizes the history of a file over a window of time.
+type FileStats struct {
+	Path         string    `json:"path"`
+	Commits      int       `json:"commits"`
+	Authors      int       `json:"authors"`
+	LinesAdded   int       `json:"linesAdded"`
+	LinesDeleted int       `json:"linesDeleted"`
+	Churn        int       `json:"churn"`
+	LastModified time.Time `json:"lastModified"`
+}
+
+// Stats summarizes the changes of the file made at or after since. A zero since summarizes the whole history.
+func (h FileHistory) Stats(since time.Time) FileStats {
+	stats := FileStats{Path: h.Path}
+	authors := make(map[string]bool)
+
+	for _, change := range h.Changes {
+		if change.Date.Before(since) {
+			continue
+		}
+
+		stats.Commits++
+		stats.LinesAdded += change.Added
+		stats.LinesDeleted += change.Deleted
+		authors[change.Author] = true
+
+		if change.Date.After(stats.LastModified) {
+			stats.LastModified = change.Date
+		}
+	}
+
+	stats.Authors = len(authors)
+	stats.Churn = stats.LinesAdded + stats.LinesDeleted
+
+	return stats
+}
+
+// Hotspots returns the stats of the files changed within window of the most recent change, ordered by the highest to
+// the lowest churn. A zero window covers the whole history.
+func Hotspots(histories []FileHistory, window time.Duration) []FileStats {
+	since := time.Time{}
+	if window > 0 {
+		latest := time.Time{}
+		for _, history := range histories {
+			for _, change := range history.Changes {
+				if change.Date.After(latest) {
+					latest = change.Date
+				}
+			}
+		}
+		since = latest.Add(-window)
+	}
+
+	hotspots := make([]FileStats, 0, len(histories))
+	for _, history := range histories {
+		stats := history.Stats(since)
+		if stats.Commits == 0 {
+			continue
+		}
+		hotspots = append(hotspots, stats)
+	}
+
+	sort.SliceStable(hotspots, func(i, j int) bool {
+		if hotspots[i].Churn != hotspots[j].Churn {
+			return hotspots[i].Churn > hotspots[j].Churn
+		}
+		return hotspots[i].Commits > hotspots[j].Commits
+	})
+
+	return hotspots
+}
+
+// getFileHistories collects the changes made by non-merge commits to every file present at the newest commit of
+// history.
+//
+// Merge commits are skipped in the same way git log --numstat does not report them, so that changes are credited to
+// the commits that made them. Files are followed across renames and copies like git log --follow, so the history of a
+// file continues with the changes made to the file it was renamed or copied from. Trees and parents are read from the
+// commit graph, and only the commits changing a followed file are inflated for their author and date.
+func (r *RepoReader) getFileHistories(history *commitHistory) ([]FileHistory, error) {
+	histories := make([]FileHistory, 0)
+	if len(history.nodes) == 0 {
+		return histories, nil
+	}
+
+	headTree, err := history.nodes[0].Tree()
+	if err != nil {
+		return nil, fmt.Errorf("getFileHistories: unable to read tree of commit %s: %w", history.nodes[0].ID(), err)
+	}
+
+	// followed maps the path of a file at the commit being walked to the paths at the newest commit whose history it
+	// is part of.
+	changesByPath := make(map[string][]FileChange)
+	followed := make(map[string][]string)
+	err = headTree.Files().ForEach(func(f *object.File) error {
+		changesByPath[f.Name] = make([]FileChange, 0)
+		followed[f.Name] = []string{f.Name}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getFileHistories: unable to list files of commit %s: %w", history.nodes[0].ID(), err)
+	}
+
+	for _, node := range history.nodes {
+		if node.NumParents() > 1 {
+			continue
+		}
+
+		changes, err := r.getFirstParentChanges(node)
+		if err != nil {
+			return nil, fmt.Errorf("getFileHistories: %w", err)
+		}
+
+		// Paths are only updated once every change of the commit is recorded so that the source of a copy modified by
+		// the same commit is not credited to the copy.
+		started := make([]string, 0)
+		origins := make(map[string][]string)
+		var commit *object.Commit
+
+		for _, change := range changes {
+			path := change.To.Name
+			if path == "" {
+				path = change.From.Name
+			}
+			heads, ok := followed[path]
+			if !ok {
+				continue
+			}
+
+			if commit == nil {
+				commit, err = history.commit(node)
+				if err != nil {
+					return nil, fmt.Errorf("getFileHistories: %w", err)
+				}
+			}
+
+			// Renames made by bots left out of the analysis are still followed, their changes are just not recorded.
+			if !r.excludesBot(commit.Author.Name, commit.Author.Email) {
+				added, deleted, err := r.countChangedLines(change.Change)
+				if err != nil {
+					return nil, fmt.Errorf("getFileHistories: unable to count lines of %s at commit %s: %w", path, commit.Hash, err)
+				}
+
+				for _, head := range heads {
+					changesByPath[head] = append(changesByPath[head], FileChange{
+						Hash:    commit.Hash.String(),
+						Author:  commit.Author.Email,
+						Date:    commit.Author.When,
+						Added:   added,
+						Deleted: deleted,
+					})
+				}
+			}
+
+			switch {
+			case change.From.Name == "":
+				started = append(started, path)
+			case change.From.Name != change.To.Name && change.To.Name != "":
+				if !change.copied {
+					started = append(started, path)
+				}
+				origins[change.From.Name] = append(origins[change.From.Name], heads...)
+			}
+		}
+
+		for _, path := range started {
+			delete(followed, path)
+		}
+		for path, heads := range origins {
+			followed[path] = append(followed[path], heads...)
+		}
+	}
+
+	for path, changes := range changesByPath {
+		histories = append(histories, FileHistory{Path: path, Changes: changes})
+	}
+
+	sort.Slice(histories, func(i, j int) bool {
+		return histories[i].Path < histories[j].Path
+	})
+
+	return histories, nil
+}
+
+// getFirstParentChanges returns the changes between the first parent of node, or an empty tree for root commits, and
+// node.
+func (r *RepoReader) getFirstParentChanges(node commitgraph.CommitNode) ([]treeChange, error) {
+	tree, err := node.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read tree of commit %s: %w", node.ID(), err)
+	}
+
+	var parentTree *object.Tree
+	if node.NumParents() > 0 {
+		parentTree, err = getParentTree(node, 0)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	changes, err := r.diffTrees(parentTree, tree)
+	if err != nil {
+		return nil, fmt.Errorf("unable to diff tree of commit %s: %w", node.ID(), err)
+	}
+
+	return changes, nil
+}
+
+// countChangedLines returns the number of lines added and deleted by change. Binary files and submodules have no
+// lines.
+func (r *RepoReader) countChangedLines(change *object.Change) (int, int, error) {
+	from, err := r.readChangeSide(change.From)
+	if err != nil {
+		return 0, 0, err
+	}
+	to, err := r.readChangeSide(change.To)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	added, deleted := 0, 0
+	for _, d := range diff.Do(from, to) {
+		switch d.Type {
+		case diffmatchpatch.DiffInsert:
+			added += countLines(d.Text)
+		case diffmatchpatch.DiffDelete:
+			deleted += countLines(d.Text)
+		case diffmatchpatch.DiffEqual:
+		}
+	}
+
+	return added, deleted, nil
+}
+
+// readChangeSide returns the text content of one side of a change, or an empty string if the side does not exist or
+// is not a text file.
+func (r *RepoReader) readChangeSide(entry object.ChangeEntry) (string, error) {
+	if entry.Name == "" || entry.TreeEntry.Mode == filemode.Submodule {
+		return "", nil
+	}
+
+	content, isBinary, err := r.readBlob(entry.TreeEntry.Hash)
+	if err != nil {
+		return "", err
+	}
+	if isBinary {
+		return "", nil
+	}
+
+	return content, nil
+}
+
+// GetFileHistories returns the changes made to each file present at HEAD by non-merge commits.
+func (r *RepoReader) GetFileHistories() ([]FileHistory, error) {
+	history, err := r.getHistory()
+	if err != nil {
+		return nil, fmt.Errorf("GetFileHistories: unable to get the repository history: %w", err)
+	}
+	defer history.close()
+
+	histories, err := r.getFileHistories(history)
+	if err != nil {
+		return nil, fmt.Errorf("GetFileHistories: %w", err)
+	}
+
+	return histories, nil
+}
@@ -0,0 +1,305 @@
+package reporeader_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/djyuhn/gitcha/gittest"
+	"github.com/djyuhn/gitcha/internal/reporeader"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoReader_GetFileHistories(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given commits changing files should return changes with line counts for files at HEAD", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+		authorOne := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: start}
+		authorTwo := object.Signature{Name: "Gitcha Two", Email: "gitcha2@gitcha.com", When: start.Add(time.Hour)}
+
+		commits := []gittest.LocalCommit{
+			{
+				Author:  authorOne,
+				Message: "Add files",
+				Files: map[string]string{
+					"main.go":   "one\ntwo\n",
+					"delete.go": "gone\n",
+				},
+			},
+			{
+				Author:  authorTwo,
+				Message: "Change main and delete file",
+				Files:   map[string]string{"main.go": "one\nTWO\nthree\n"},
+				Removed: []string{"delete.go"},
+			},
+		}
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetFileHistories()
+		require.NoError(t, err)
+
+		require.Len(t, actual, 1)
+		assert.Equal(t, "main.go", actual[0].Path)
+		require.Len(t, actual[0].Changes, 2)
+
+		assert.Equal(t, authorTwo.Email, actual[0].Changes[0].Author)
+		assert.Equal(t, 2, actual[0].Changes[0].Added)
+		assert.Equal(t, 1, actual[0].Changes[0].Deleted)
+		assert.True(t, authorTwo.When.Equal(actual[0].Changes[0].Date))
+
+		assert.Equal(t, authorOne.Email, actual[0].Changes[1].Author)
+		assert.Equal(t, 2, actual[0].Changes[1].Added)
+		assert.Equal(t, 0, actual[0].Changes[1].Deleted)
+	})
+	t.Run("given renamed file should follow history across the rename", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+		authorOne := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: start}
+		authorTwo := object.Signature{Name: "Gitcha Two", Email: "gitcha2@gitcha.com", When: start.Add(time.Hour)}
+
+		commits := []gittest.LocalCommit{
+			{
+				Author:  authorOne,
+				Message: "Add old",
+				Files:   map[string]string{"old.go": "one\ntwo\nthree\nfour\n"},
+			},
+			{
+				Author:  authorTwo,
+				Message: "Rename old to new",
+				Files:   map[string]string{"new.go": "one\ntwo\nthree\nfour\nfive\n"},
+				Removed: []string{"old.go"},
+			},
+		}
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetFileHistories()
+		require.NoError(t, err)
+
+		require.Len(t, actual, 1)
+		assert.Equal(t, "new.go", actual[0].Path)
+		require.Len(t, actual[0].Changes, 2)
+
+		assert.Equal(t, authorTwo.Email, actual[0].Changes[0].Author)
+		assert.Equal(t, 1, actual[0].Changes[0].Added)
+		assert.Equal(t, 0, actual[0].Changes[0].Deleted)
+
+		assert.Equal(t, authorOne.Email, actual[0].Changes[1].Author)
+		assert.Equal(t, 4, actual[0].Changes[1].Added)
+	})
+
+	t.Run("given copied file should follow history of the file it was copied from", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+		authorOne := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: start}
+		authorTwo := object.Signature{Name: "Gitcha Two", Email: "gitcha2@gitcha.com", When: start.Add(time.Hour)}
+
+		commits := []gittest.LocalCommit{
+			{
+				Author:  authorOne,
+				Message: "Add source",
+				Files:   map[string]string{"source.go": "one\ntwo\nthree\nfour\n"},
+			},
+			{
+				Author:  authorTwo,
+				Message: "Copy source and change it",
+				Files: map[string]string{
+					"source.go": "one\ntwo\nthree\nfour\nchanged\n",
+					"copy.go":   "one\ntwo\nthree\nfour\n",
+				},
+			},
+		}
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetFileHistories()
+		require.NoError(t, err)
+
+		require.Len(t, actual, 2)
+		assert.Equal(t, "copy.go", actual[0].Path)
+		require.Len(t, actual[0].Changes, 2)
+		assert.Equal(t, 0, actual[0].Changes[0].Added)
+		assert.Equal(t, authorOne.Email, actual[0].Changes[1].Author)
+
+		assert.Equal(t, "source.go", actual[1].Path)
+		require.Len(t, actual[1].Changes, 2)
+		assert.Equal(t, 1, actual[1].Changes[0].Added)
+	})
+
+	t.Run("given renamed file and zero similarity should not follow the rename", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+		author := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: start}
+
+		commits := []gittest.LocalCommit{
+			{
+				Author:  author,
+				Message: "Add old",
+				Files:   map[string]string{"old.go": "one\ntwo\n"},
+			},
+			{
+				Author:  object.Signature{Name: author.Name, Email: author.Email, When: start.Add(time.Hour)},
+				Message: "Rename old to new",
+				Files:   map[string]string{"new.go": "one\ntwo\n"},
+				Removed: []string{"old.go"},
+			},
+		}
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo, reporeader.WithSimilarity(0))
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetFileHistories()
+		require.NoError(t, err)
+
+		require.Len(t, actual, 1)
+		assert.Equal(t, "new.go", actual[0].Path)
+		require.Len(t, actual[0].Changes, 1)
+		assert.Equal(t, 2, actual[0].Changes[0].Added)
+	})
+
+	t.Run("given similarity of 100 should only follow exact renames", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+		author := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: start}
+
+		commits := []gittest.LocalCommit{
+			{
+				Author:  author,
+				Message: "Add files",
+				Files: map[string]string{
+					"exact.go":   "one\ntwo\nthree\nfour\n",
+					"changed.go": "five\nsix\nseven\neight\n",
+				},
+			},
+			{
+				Author:  object.Signature{Name: author.Name, Email: author.Email, When: start.Add(time.Hour)},
+				Message: "Rename files",
+				Files: map[string]string{
+					"exact_renamed.go":   "one\ntwo\nthree\nfour\n",
+					"changed_renamed.go": "five\nsix\nseven\neight\nnine\n",
+				},
+				Removed: []string{"exact.go", "changed.go"},
+			},
+		}
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo, reporeader.WithSimilarity(100))
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetFileHistories()
+		require.NoError(t, err)
+
+		require.Len(t, actual, 2)
+		assert.Equal(t, "changed_renamed.go", actual[0].Path)
+		assert.Len(t, actual[0].Changes, 1)
+		assert.Equal(t, "exact_renamed.go", actual[1].Path)
+		assert.Len(t, actual[1].Changes, 2)
+	})
+}
+
+func TestFileHistory_Stats(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+	history := reporeader.FileHistory{
+		Path: "main.go",
+		Changes: []reporeader.FileChange{
+			{Hash: "c", Author: "gitcha1@gitcha.com", Date: start.Add(48 * time.Hour), Added: 3, Deleted: 1},
+			{Hash: "b", Author: "gitcha2@gitcha.com", Date: start.Add(24 * time.Hour), Added: 2, Deleted: 2},
+			{Hash: "a", Author: "gitcha1@gitcha.com", Date: start, Added: 10},
+		},
+	}
+
+	t.Run("given zero since should summarize the whole history", func(t *testing.T) {
+		t.Parallel()
+
+		expected := reporeader.FileStats{
+			Path:         "main.go",
+			Commits:      3,
+			Authors:      2,
+			LinesAdded:   15,
+			LinesDeleted: 3,
+			Churn:        18,
+			LastModified: start.Add(48 * time.Hour),
+		}
+
+		assert.Equal(t, expected, history.Stats(time.Time{}))
+	})
+
+	t.Run("given since should only summarize changes at or after since", func(t *testing.T) {
+		t.Parallel()
+
+		expected := reporeader.FileStats{
+			Path:         "main.go",
+			Commits:      2,
+			Authors:      2,
+			LinesAdded:   5,
+			LinesDeleted: 3,
+			Churn:        8,
+			LastModified: start.Add(48 * time.Hour),
+		}
+
+		assert.Equal(t, expected, history.Stats(start.Add(24*time.Hour)))
+	})
+}
+
+func TestHotspots(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+	histories := []reporeader.FileHistory{
+		{
+			Path:    "old.go",
+			Changes: []reporeader.FileChange{{Hash: "a", Author: "gitcha1@gitcha.com", Date: start, Added: 100}},
+		},
+		{
+			Path:    "new.go",
+			Changes: []reporeader.FileChange{{Hash: "b", Author: "gitcha1@gitcha.com", Date: start.Add(30 * 24 * time.Hour), Added: 5}},
+		},
+		{
+			Path:    "untouched.go",
+			Changes: []reporeader.FileChange{},
+		},
+	}
+
+	t.Run("given zero window should rank all changed files by churn", func(t *testing.T) {
+		t.Parallel()
+
+		actual := reporeader.Hotspots(histories, 0)
+
+		require.Len(t, actual, 2)
+		assert.Equal(t, "old.go", actual[0].Path)
+		assert.Equal(t, "new.go", actual[1].Path)
+	})
+
+	t.Run("given window should only rank files changed within window of the latest change", func(t *testing.T) {
+		t.Parallel()
+
+		actual := reporeader.Hotspots(histories, 7*24*time.Hour)
+
+		require.Len(t, actual, 1)
+		assert.Equal(t, "new.go", actual[0].Path)
+	})
+}
@@ -0,0 +1,67 @@
+package reporeader
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// trailerPattern matches a git trailer line such as "Co-authored-by: Name <email>".
+	trailerPattern = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9-]*)\s*:\s*(.+)$`)
+	// identityPattern matches an identity such as "Name <email>".
+	identityPattern = regexp.MustCompile(`^(.*?)\s*<([^<>\s]+)>$`)
+)
+
+// parseCoAuthors returns the identities of the trailers of message whose key matches one of trailers, ignoring case,
+// in the order they appear. Trailers are read from the last paragraph of the message, the same way git interprets
+// them, and identities sharing an email with author or an earlier trailer are skipped.
+func parseCoAuthors(message string, author Author, trailers []string) []Author {
+	if len(trailers) == 0 {
+		return nil
+	}
+
+	paragraphs := strings.Split(strings.TrimSpace(strings.ReplaceAll(message, "\r\n", "\n")), "\n\n")
+	if len(paragraphs) < 2 {
+		return nil
+	}
+
+	seen := map[string]bool{strings.ToLower(author.Email): true}
+	coAuthors := make([]Author, 0)
+
+	for _, line := range strings.Split(paragraphs[len(paragraphs)-1], "\n") {
+		match := trailerPattern.FindStringSubmatch(strings.TrimSpace(line))
+		if match == nil || !containsFold(trailers, match[1]) {
+			continue
+		}
+
+		identity := identityPattern.FindStringSubmatch(strings.TrimSpace(match[2]))
+		if identity == nil {
+			continue
+		}
+
+		email := strings.ToLower(identity[2])
+		if seen[email] {
+			continue
+		}
+		seen[email] = true
+
+		coAuthors = append(coAuthors, Author{Name: identity[1], Email: identity[2]})
+	}
+
+	if len(coAuthors) == 0 {
+		return nil
+	}
+
+	return coAuthors
+}
+
+// containsFold returns whether values contains value, ignoring case.
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
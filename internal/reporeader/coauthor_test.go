@@ -0,0 +1,161 @@
+package reporeader_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/djyuhn/gitcha/gittest"
+	"github.com/djyuhn/gitcha/internal/reporeader"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoReader_GetAuthorsByCommits_CoAuthors(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+	author := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: start}
+	pairedMessage := "Pair on parser\n\nSome details.\n\n" +
+		"Co-authored-by: Gitcha Two <gitcha2@gitcha.com>\n" +
+		"co-authored-by: Gitcha One <GITCHA1@gitcha.com>\n" +
+		"Signed-off-by: Gitcha One <gitcha1@gitcha.com>\n" +
+		"Paired-with: Gitcha Three <gitcha3@gitcha.com>"
+
+	createRepo := func(t *testing.T, message string) *git.Repository {
+		t.Helper()
+
+		commits := []gittest.LocalCommit{
+			{Author: author, Message: message, Files: map[string]string{"file.txt": "one\n"}},
+		}
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		return repo
+	}
+
+	t.Run("given co-author trailers and shared credit should credit commit to author and co-authors", func(t *testing.T) {
+		t.Parallel()
+
+		repoReader, err := reporeader.NewRepoReaderRepository(createRepo(t, pairedMessage),
+			reporeader.WithCreditMode(reporeader.CreditShared))
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetAuthorsByCommits()
+		require.NoError(t, err)
+
+		require.Len(t, actual, 2)
+		require.Len(t, actual["gitcha1@gitcha.com"], 1)
+		require.Len(t, actual["gitcha2@gitcha.com"], 1)
+
+		commit := actual["gitcha2@gitcha.com"][0]
+		assert.Equal(t, reporeader.Author{Name: author.Name, Email: author.Email}, commit.Author)
+		assert.Equal(t, []reporeader.Author{{Name: "Gitcha Two", Email: "gitcha2@gitcha.com"}}, commit.CoAuthors)
+	})
+
+	t.Run("given co-author trailers and primary credit should credit commit to author alone", func(t *testing.T) {
+		t.Parallel()
+
+		repoReader, err := reporeader.NewRepoReaderRepository(createRepo(t, pairedMessage),
+			reporeader.WithCreditMode(reporeader.CreditPrimary))
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetAuthorsByCommits()
+		require.NoError(t, err)
+
+		require.Len(t, actual, 1)
+		require.Len(t, actual["gitcha1@gitcha.com"], 1)
+		assert.Len(t, actual["gitcha1@gitcha.com"][0].CoAuthors, 1)
+	})
+
+	t.Run("given co-author trailers and default credit should credit commit to author alone", func(t *testing.T) {
+		t.Parallel()
+
+		repoReader, err := reporeader.NewRepoReaderRepository(createRepo(t, pairedMessage))
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetAuthorsByCommits()
+		require.NoError(t, err)
+
+		require.Len(t, actual, 1)
+		require.Len(t, actual["gitcha1@gitcha.com"], 1)
+		assert.Equal(t, []reporeader.Author{{Name: "Gitcha Two", Email: "gitcha2@gitcha.com"}}, actual["gitcha1@gitcha.com"][0].CoAuthors)
+	})
+
+	t.Run("given configured trailers should credit identities of every configured trailer", func(t *testing.T) {
+		t.Parallel()
+
+		repoReader, err := reporeader.NewRepoReaderRepository(createRepo(t, pairedMessage),
+			reporeader.WithCoAuthorTrailers("Co-authored-by", "Paired-with"), reporeader.WithCreditMode(reporeader.CreditShared))
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetAuthorsByCommits()
+		require.NoError(t, err)
+
+		assert.Len(t, actual, 3)
+		assert.Len(t, actual["gitcha3@gitcha.com"], 1)
+	})
+
+	t.Run("given co-author line outside the last paragraph should not credit it", func(t *testing.T) {
+		t.Parallel()
+
+		message := "Fix parser\n\nCo-authored-by: Gitcha Two <gitcha2@gitcha.com>\n\nMore details."
+		repoReader, err := reporeader.NewRepoReaderRepository(createRepo(t, message))
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetAuthorsByCommits()
+		require.NoError(t, err)
+
+		require.Len(t, actual, 1)
+		assert.Nil(t, actual["gitcha1@gitcha.com"][0].CoAuthors)
+	})
+}
+
+func TestCommit_Contributor(t *testing.T) {
+	t.Parallel()
+
+	commit := reporeader.Commit{
+		Author:    reporeader.Author{Name: "Gitcha One", Email: "gitcha1@gitcha.com"},
+		CoAuthors: []reporeader.Author{{Name: "Gitcha Two", Email: "gitcha2@gitcha.com"}},
+	}
+
+	t.Run("given co-author email should return the co-author", func(t *testing.T) {
+		t.Parallel()
+
+		actual, ok := commit.Contributor("gitcha2@gitcha.com")
+
+		assert.True(t, ok)
+		assert.Equal(t, "Gitcha Two", actual.Name)
+	})
+
+	t.Run("given unknown email should return false", func(t *testing.T) {
+		t.Parallel()
+
+		_, ok := commit.Contributor("gitcha3@gitcha.com")
+
+		assert.False(t, ok)
+	})
+}
+
+func TestParseCreditMode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given supported mode should return it", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := reporeader.ParseCreditMode("primary")
+
+		assert.NoError(t, err)
+		assert.Equal(t, reporeader.CreditPrimary, actual)
+	})
+
+	t.Run("given unsupported mode should return error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := reporeader.ParseCreditMode("everyone")
+
+		assert.Error(t, err)
+	})
+}
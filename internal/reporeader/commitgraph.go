@@ -0,0 +1,362 @@
+package reporeader
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	commitgraphfmt "github.com/go-git/go-git/v5/plumbing/format/commitgraph"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/object/commitgraph"
+	"github.com/go-git/go-git/v5/storage/filesystem"
+)
+
+var commitGraphPath = path.Join("objects", "info", "commit-graph")
+
+// getCommitNodeIndex returns an index over the commit graph of the repository.
+//
+// When the repository has a commit-graph file the index reads parent lists, commit times and generation numbers from
+// it and only inflates commit objects on demand. Otherwise, every node is backed by its commit object. The returned
+// closer is nil when no commit-graph file was opened.
+func (r *RepoReader) getCommitNodeIndex() (commitgraph.CommitNodeIndex, io.Closer) {
+	storage, ok := r.repository.Storer.(*filesystem.Storage)
+	if !ok {
+		return commitgraph.NewObjectCommitNodeIndex(r.repository.Storer), nil
+	}
+
+	file, err := storage.Filesystem().Open(commitGraphPath)
+	if err != nil {
+		return commitgraph.NewObjectCommitNodeIndex(r.repository.Storer), nil
+	}
+
+	index, err := commitgraphfmt.OpenFileIndex(file)
+	if err != nil {
+		_ = file.Close()
+		return commitgraph.NewObjectCommitNodeIndex(r.repository.Storer), nil
+	}
+
+	return commitgraph.NewGraphCommitNodeIndex(index, r.repository.Storer), file
+}
+
+// walkCommitNodes walks the commit graph reachable from the given hash ordered by committer time, calling fn for
+// each visited node. Nodes must not be retained after the walk as the commit-graph file backing them is closed.
+func (r *RepoReader) walkCommitNodes(from plumbing.Hash, fn func(commitgraph.CommitNode) error) error {
+	index, closer := r.getCommitNodeIndex()
+	if closer != nil {
+		defer closer.Close()
+	}
+
+	node, err := index.Get(from)
+	if err != nil {
+		return fmt.Errorf("walkCommitNodes: unable to find commit %s: %w", from, err)
+	}
+
+	if err := commitgraph.NewCommitNodeIterCTime(node, nil, nil).ForEach(fn); err != nil {
+		return fmt.Errorf("walkCommitNodes: unable to walk the commit graph: %w", err)
+	}
+
+	return nil
+}
+
+// commitHistory is the history of a commit walked over the commit graph, ordered by committer time with the commit
+// first.
+//
+// The nodes read parent lists, commit times and tree hashes from the commit-graph file when there is one, so analyses
+// that only need the shape of the history and its trees never read the commit objects. Commit objects are inflated
+// once, and only for the analyses that need the author, message or signature of a commit.
+type commitHistory struct {
+	nodes   []commitgraph.CommitNode
+	commits map[plumbing.Hash]*object.Commit
+	closer  io.Closer
+}
+
+// getHistory returns the history of HEAD. It must be closed once the analyses are done with its nodes.
+func (r *RepoReader) getHistory() (*commitHistory, error) {
+	head, err := r.repository.Head()
+	if err != nil {
+		return nil, fmt.Errorf("getHistory: unable to get the repository head: %w", err)
+	}
+
+	index, closer := r.getCommitNodeIndex()
+	history := &commitHistory{nodes: make([]commitgraph.CommitNode, 0), commits: make(map[plumbing.Hash]*object.Commit), closer: closer}
+
+	node, err := index.Get(head.Hash())
+	if err != nil {
+		history.close()
+		return nil, fmt.Errorf("getHistory: unable to find commit %s: %w", head.Hash(), err)
+	}
+
+	err = commitgraph.NewCommitNodeIterCTime(node, nil, nil).ForEach(func(node commitgraph.CommitNode) error {
+		history.nodes = append(history.nodes, node)
+		return nil
+	})
+	if err != nil {
+		history.close()
+		return nil, fmt.Errorf("getHistory: unable to walk the commit graph: %w", err)
+	}
+
+	return history, nil
+}
+
+// commit returns the commit object of node, inflating it on first use.
+func (h *commitHistory) commit(node commitgraph.CommitNode) (*object.Commit, error) {
+	if commit, ok := h.commits[node.ID()]; ok {
+		return commit, nil
+	}
+
+	commit, err := node.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read commit %s: %w", node.ID(), err)
+	}
+	h.commits[node.ID()] = commit
+
+	return commit, nil
+}
+
+// allCommits returns the commit object of every node of the history, in the order of the nodes.
+func (h *commitHistory) allCommits() ([]*object.Commit, error) {
+	commits := make([]*object.Commit, 0, len(h.nodes))
+	for _, node := range h.nodes {
+		commit, err := h.commit(node)
+		if err != nil {
+			return nil, err
+		}
+		commits = append(commits, commit)
+	}
+
+	return commits, nil
+}
+
+// close releases the commit-graph file backing the nodes, which must not be used afterwards.
+func (h *commitHistory) close() {
+	if h.closer != nil {
+		_ = h.closer.Close()
+	}
+}
+
+// getCommits returns the commits reachable from HEAD ordered by committer time. Every commit is inflated, so analyses
+// that do not need the author or message of each commit walk the nodes of getHistory instead.
+func (r *RepoReader) getCommits() ([]*object.Commit, error) {
+	history, err := r.getHistory()
+	if err != nil {
+		return nil, fmt.Errorf("getCommits: %w", err)
+	}
+	defer history.close()
+
+	commits, err := history.allCommits()
+	if err != nil {
+		return nil, fmt.Errorf("getCommits: %w", err)
+	}
+
+	return commits, nil
+}
+
+// commitNode returns a node backed by an already inflated commit, for analyses given commit objects that share the
+// helpers walking commit nodes.
+func (r *RepoReader) commitNode(commit *object.Commit) (commitgraph.CommitNode, error) {
+	node, err := commitgraph.NewObjectCommitNodeIndex(r.repository.Storer).Get(commit.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("commitNode: unable to find commit %s: %w", commit.Hash, err)
+	}
+
+	return node, nil
+}
+
+// getAllCommits returns the commits reachable from HEAD or from any branch, remote-tracking branch or tag, each once.
+// The history of HEAD comes first, then the commits only reachable from each other reference, each walk ordered by
+// committer time. Tags are peeled to the commit they point at and references to other
+// objects, such as tags of trees, are skipped.
+func (r *RepoReader) getAllCommits() ([]*object.Commit, error) {
+	head, err := r.repository.Head()
+	if err != nil {
+		return nil, fmt.Errorf("getAllCommits: unable to get the repository head: %w", err)
+	}
+
+	tips := []plumbing.Hash{head.Hash()}
+	refs, err := r.repository.References()
+	if err != nil {
+		return nil, fmt.Errorf("getAllCommits: unable to list references: %w", err)
+	}
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		if ref.Type() != plumbing.HashReference {
+			return nil
+		}
+
+		hash := ref.Hash()
+		tag, err := r.repository.TagObject(hash)
+		switch {
+		case errors.Is(err, plumbing.ErrObjectNotFound):
+		case err != nil:
+			return fmt.Errorf("unable to read tag %s: %w", ref.Name(), err)
+		default:
+			commit, err := tag.Commit()
+			if err != nil {
+				return nil
+			}
+			hash = commit.Hash
+		}
+
+		if _, err := r.repository.CommitObject(hash); err == nil {
+			tips = append(tips, hash)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getAllCommits: %w", err)
+	}
+
+	index, closer := r.getCommitNodeIndex()
+	if closer != nil {
+		defer closer.Close()
+	}
+
+	// seen is shared by the walks so that the history common to several references is walked once.
+	seen := make(map[plumbing.Hash]bool)
+	hashes := make([]plumbing.Hash, 0)
+	for _, tip := range tips {
+		if seen[tip] {
+			continue
+		}
+
+		node, err := index.Get(tip)
+		if err != nil {
+			return nil, fmt.Errorf("getAllCommits: unable to find commit %s: %w", tip, err)
+		}
+
+		err = commitgraph.NewCommitNodeIterCTime(node, seen, nil).ForEach(func(node commitgraph.CommitNode) error {
+			seen[node.ID()] = true
+			hashes = append(hashes, node.ID())
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("getAllCommits: unable to walk the commit graph: %w", err)
+		}
+	}
+
+	commits := make([]*object.Commit, 0, len(hashes))
+	for _, hash := range hashes {
+		commit, err := r.repository.CommitObject(hash)
+		if err != nil {
+			return nil, fmt.Errorf("getAllCommits: unable to read commit %s: %w", hash, err)
+		}
+		commits = append(commits, commit)
+	}
+
+	return commits, nil
+}
+
+// WriteCommitGraph generates a commit-graph file for the commits reachable from HEAD.
+//
+// The file is written to objects/info/commit-graph, replacing any existing one, and is used by later walks to read
+// parent lists and generation numbers without inflating every commit object. Only repositories stored on a filesystem
+// are supported.
+func (r *RepoReader) WriteCommitGraph() error {
+	storage, ok := r.repository.Storer.(*filesystem.Storage)
+	if !ok {
+		return fmt.Errorf("WriteCommitGraph: repository storage does not support commit-graph files")
+	}
+
+	head, err := r.repository.Head()
+	if err != nil {
+		return fmt.Errorf("WriteCommitGraph: unable to get the repository head: %w", err)
+	}
+
+	// Always walk the commit objects so that a stale commit-graph file is not used to build its replacement. Each
+	// commit is read once since tree hashes are only stored in the commit objects.
+	headCommit, err := r.repository.CommitObject(head.Hash())
+	if err != nil {
+		return fmt.Errorf("WriteCommitGraph: unable to find commit %s: %w", head.Hash(), err)
+	}
+
+	commits := make(map[plumbing.Hash]*object.Commit)
+	err = object.NewCommitIterCTime(headCommit, nil, nil).ForEach(func(commit *object.Commit) error {
+		commits[commit.Hash] = commit
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("WriteCommitGraph: unable to walk the commit graph: %w", err)
+	}
+
+	memoryIndex, err := buildCommitGraphIndex(commits)
+	if err != nil {
+		return fmt.Errorf("WriteCommitGraph: %w", err)
+	}
+
+	fs := storage.Filesystem()
+	tmp, err := fs.TempFile(path.Dir(commitGraphPath), "tmp_graph_")
+	if err != nil {
+		return fmt.Errorf("WriteCommitGraph: unable to create commit-graph file: %w", err)
+	}
+
+	if err := commitgraphfmt.NewEncoder(tmp).Encode(memoryIndex); err != nil {
+		_ = tmp.Close()
+		_ = fs.Remove(tmp.Name())
+		return fmt.Errorf("WriteCommitGraph: unable to encode commit-graph file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = fs.Remove(tmp.Name())
+		return fmt.Errorf("WriteCommitGraph: unable to write commit-graph file: %w", err)
+	}
+	if err := fs.Rename(tmp.Name(), commitGraphPath); err != nil {
+		_ = fs.Remove(tmp.Name())
+		return fmt.Errorf("WriteCommitGraph: unable to replace commit-graph file: %w", err)
+	}
+
+	return nil
+}
+
+// buildCommitGraphIndex creates an in memory commit-graph index for commits, computing the generation number of each
+// commit as one more than the highest generation of its parents.
+func buildCommitGraphIndex(commits map[plumbing.Hash]*object.Commit) (*commitgraphfmt.MemoryIndex, error) {
+	generations := make(map[plumbing.Hash]int, len(commits))
+
+	// Iterative depth-first traversal so that deep histories do not exhaust the stack.
+	for hash := range commits {
+		stack := []plumbing.Hash{hash}
+		for len(stack) > 0 {
+			current := stack[len(stack)-1]
+			if _, ok := generations[current]; ok {
+				stack = stack[:len(stack)-1]
+				continue
+			}
+
+			commit, ok := commits[current]
+			if !ok {
+				return nil, fmt.Errorf("buildCommitGraphIndex: commit %s is missing from the walk", current)
+			}
+
+			generation := 1
+			pending := false
+			for _, parent := range commit.ParentHashes {
+				parentGeneration, ok := generations[parent]
+				if !ok {
+					stack = append(stack, parent)
+					pending = true
+					continue
+				}
+				if parentGeneration+1 > generation {
+					generation = parentGeneration + 1
+				}
+			}
+
+			if !pending {
+				generations[current] = generation
+				stack = stack[:len(stack)-1]
+			}
+		}
+	}
+
+	index := commitgraphfmt.NewMemoryIndex()
+	for hash, commit := range commits {
+		index.Add(hash, &commitgraphfmt.CommitData{
+			TreeHash:     commit.TreeHash,
+			ParentHashes: commit.ParentHashes,
+			Generation:   generations[hash],
+			When:         commit.Committer.When,
+		})
+	}
+
+	return index, nil
+}
@@ -0,0 +1,189 @@
+package reporeader_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/djyuhn/gitcha/gittest"
+	"github.com/djyuhn/gitcha/internal/reporeader"
+
+	commitgraphfmt "github.com/go-git/go-git/v5/plumbing/format/commitgraph"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoReader_WriteCommitGraph(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given repository should write commit-graph file with generation numbers and nil error", func(t *testing.T) {
+		t.Parallel()
+
+		dir, repo, err := gittest.CreateLocalRepo(t, linearHistory(3))
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		err = repoReader.WriteCommitGraph()
+		require.NoError(t, err)
+
+		file, err := os.Open(filepath.Join(dir, ".git", "objects", "info", "commit-graph"))
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, file.Close())
+		})
+
+		index, err := commitgraphfmt.OpenFileIndex(file)
+		require.NoError(t, err)
+
+		head, err := repo.Head()
+		require.NoError(t, err)
+
+		headIndex, err := index.GetIndexByHash(head.Hash())
+		require.NoError(t, err)
+		headData, err := index.GetCommitDataByIndex(headIndex)
+		require.NoError(t, err)
+
+		assert.Len(t, index.Hashes(), 3)
+		assert.Equal(t, 3, headData.Generation)
+	})
+
+	t.Run("given repository with commit-graph file should return the same authors commits", func(t *testing.T) {
+		t.Parallel()
+
+		_, repo, err := gittest.CreateLocalRepo(t, linearHistory(5))
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		expected, err := repoReader.GetAuthorsByCommits()
+		require.NoError(t, err)
+
+		require.NoError(t, repoReader.WriteCommitGraph())
+
+		actual, err := repoReader.GetAuthorsByCommits()
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func BenchmarkRepoReader_GetFileHistories(b *testing.B) {
+	for _, withCommitGraph := range []bool{false, true} {
+		withCommitGraph := withCommitGraph
+		b.Run(fmt.Sprintf("commit-graph=%t", withCommitGraph), func(b *testing.B) {
+			dir, repo, err := gittest.CreateLocalRepo(b, spreadHistory(benchmarkCommitCount, benchmarkFileCount))
+			require.NoError(b, err)
+
+			if withCommitGraph {
+				repoReader, err := reporeader.NewRepoReaderRepository(repo)
+				require.NoError(b, err)
+				require.NoError(b, repoReader.WriteCommitGraph())
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				// Every iteration opens the repository again so that no commit is served from the object cache.
+				repoReader, err := reporeader.NewRepoReader(dir)
+				require.NoError(b, err)
+
+				_, err = repoReader.GetFileHistories()
+				require.NoError(b, err)
+			}
+		})
+	}
+}
+
+func BenchmarkRepoReader_GetOwnership(b *testing.B) {
+	for _, withCommitGraph := range []bool{false, true} {
+		withCommitGraph := withCommitGraph
+		b.Run(fmt.Sprintf("commit-graph=%t", withCommitGraph), func(b *testing.B) {
+			dir, repo, err := gittest.CreateLocalRepo(b, spreadHistory(benchmarkCommitCount, benchmarkFileCount))
+			require.NoError(b, err)
+
+			if withCommitGraph {
+				repoReader, err := reporeader.NewRepoReaderRepository(repo)
+				require.NoError(b, err)
+				require.NoError(b, repoReader.WriteCommitGraph())
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				repoReader, err := reporeader.NewRepoReader(dir)
+				require.NoError(b, err)
+
+				_, err = repoReader.GetOwnership()
+				require.NoError(b, err)
+			}
+		})
+	}
+}
+
+func BenchmarkRepoReader_GetRepoSize(b *testing.B) {
+	dir, _, err := gittest.CreateLocalRepo(b, spreadHistory(benchmarkCommitCount, benchmarkFileCount))
+	require.NoError(b, err)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		repoReader, err := reporeader.NewRepoReader(dir)
+		require.NoError(b, err)
+
+		_, err = repoReader.GetRepoSize()
+		require.NoError(b, err)
+	}
+}
+
+const (
+	benchmarkCommitCount = 500
+	benchmarkFileCount   = 50
+)
+
+// linearHistory creates count commits by a single author each an hour apart.
+func linearHistory(count int) []gittest.LocalCommit {
+	start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+
+	commits := make([]gittest.LocalCommit, 0, count)
+	for i := 0; i < count; i++ {
+		commits = append(commits, gittest.LocalCommit{
+			Author: object.Signature{
+				Name:  "gitcha-author-name",
+				Email: "gitcha-author-email@gitcha.com",
+				When:  start.Add(time.Duration(i) * time.Hour),
+			},
+			Message: fmt.Sprintf("Commit %d", i),
+			Files:   map[string]string{"file.txt": fmt.Sprintf("content %d\n", i)},
+		})
+	}
+
+	return commits
+}
+
+// spreadHistory creates count commits by a single author each an hour apart, each appending a line to one of
+// fileCount files in turn.
+func spreadHistory(count, fileCount int) []gittest.LocalCommit {
+	start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+
+	contents := make([]string, fileCount)
+	commits := make([]gittest.LocalCommit, 0, count)
+	for i := 0; i < count; i++ {
+		file := i % fileCount
+		contents[file] += fmt.Sprintf("line %d\n", i)
+
+		commits = append(commits, gittest.LocalCommit{
+			Author: object.Signature{
+				Name:  "gitcha-author-name",
+				Email: "gitcha-author-email@gitcha.com",
+				When:  start.Add(time.Duration(i) * time.Hour),
+			},
+			Message: fmt.Sprintf("Commit %d", i),
+			Files:   map[string]string{fmt.Sprintf("file-%d.txt", file): contents[file]},
+		})
+	}
+
+	return commits
+}
@@ -0,0 +1,196 @@
+package reporeader
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// monthLayout formats the month a commit was authored in, the period conventions are broken down by over time.
+const monthLayout = "2006-01"
+
+var (
+	// conventionalSubjectPattern matches a Conventional Commits subject such as "feat(parser)!: add arrays".
+	conventionalSubjectPattern = regexp.MustCompile(`^([A-Za-z]+)(?:\(([^()]*)\))?(!)?: (\S.*)$`)
+	// breakingFooterPattern matches the footer that marks a breaking change.
+	breakingFooterPattern = regexp.MustCompile(`^BREAKING[ -]CHANGE: `)
+)
+
+// ConventionalCommit is a commit message following the Conventional Commits specification.
+type ConventionalCommit struct {
+	Type        string `json:"type"`
+	Scope       string `json:"scope,omitempty"`
+	Breaking    bool   `json:"breaking"`
+	Description string `json:"description"`
+}
+
+// ParseConventionalCommit parses the subject of message as a Conventional Commits subject. Types are lowercased and
+// commits are breaking when the subject has a ! before the colon or the message has a BREAKING CHANGE footer. False is
+// returned for messages that do not follow the specification.
+func ParseConventionalCommit(message string) (ConventionalCommit, bool) {
+	message = strings.ReplaceAll(strings.TrimSpace(message), "\r\n", "\n")
+	subject, body, _ := strings.Cut(message, "\n")
+
+	match := conventionalSubjectPattern.FindStringSubmatch(strings.TrimSpace(subject))
+	if match == nil {
+		return ConventionalCommit{}, false
+	}
+
+	commit := ConventionalCommit{
+		Type:        strings.ToLower(match[1]),
+		Scope:       strings.TrimSpace(match[2]),
+		Breaking:    match[3] == "!",
+		Description: strings.TrimSpace(match[4]),
+	}
+
+	for _, line := range strings.Split(body, "\n") {
+		if breakingFooterPattern.MatchString(line) {
+			commit.Breaking = true
+			break
+		}
+	}
+
+	return commit, true
+}
+
+// ConventionBreakdown holds the number of commits following the Conventional Commits specification and how they are
+// spread across types and scopes.
+type ConventionBreakdown struct {
+	Commits    int            `json:"commits"`
+	Conforming int            `json:"conforming"`
+	Breaking   int            `json:"breaking"`
+	Types      map[string]int `json:"types"`
+	Scopes     map[string]int `json:"scopes"`
+}
+
+// NonConformingShare returns the share of commits not following the specification as a percentage.
+func (b ConventionBreakdown) NonConformingShare() float64 {
+	if b.Commits == 0 {
+		return 0
+	}
+
+	return float64(b.Commits-b.Conforming) * percent / float64(b.Commits)
+}
+
+// SortedTypes returns the types ordered by the highest to the lowest commit count, then by name.
+func (b ConventionBreakdown) SortedTypes() []string {
+	return sortedByCount(b.Types)
+}
+
+// SortedScopes returns the scopes ordered by the highest to the lowest commit count, then by name.
+func (b ConventionBreakdown) SortedScopes() []string {
+	return sortedByCount(b.Scopes)
+}
+
+// add counts commit, which is nil for commits not following the specification.
+func (b *ConventionBreakdown) add(commit *ConventionalCommit) {
+	if b.Types == nil {
+		b.Types = make(map[string]int)
+		b.Scopes = make(map[string]int)
+	}
+
+	b.Commits++
+	if commit == nil {
+		return
+	}
+
+	b.Conforming++
+	b.Types[commit.Type]++
+	if commit.Scope != "" {
+		b.Scopes[commit.Scope]++
+	}
+	if commit.Breaking {
+		b.Breaking++
+	}
+}
+
+// Conventions holds the Conventional Commits breakdown of the repository as a whole, of each author by email and of
+// each month formatted as YYYY-MM.
+type Conventions struct {
+	Repository ConventionBreakdown            `json:"repository"`
+	Authors    map[string]ConventionBreakdown `json:"authors"`
+	Months     map[string]ConventionBreakdown `json:"months"`
+}
+
+// SortedMonths returns the months with commits from the oldest to the newest.
+func (c Conventions) SortedMonths() []string {
+	months := make([]string, 0, len(c.Months))
+	for month := range c.Months {
+		months = append(months, month)
+	}
+	sort.Strings(months)
+
+	return months
+}
+
+// getConventions classifies the subject of every commit and breaks the results down by the credited authors and by
+// the month in the author's local time. Merge commits are left out since their subjects are generated by git or the
+// forge rather than written following the convention.
+func (r *RepoReader) getConventions(commits []*object.Commit) Conventions {
+	conventions := Conventions{
+		Repository: ConventionBreakdown{Types: make(map[string]int), Scopes: make(map[string]int)},
+		Authors:    make(map[string]ConventionBreakdown),
+		Months:     make(map[string]ConventionBreakdown),
+	}
+
+	for _, commit := range commits {
+		if commit.NumParents() > 1 {
+			continue
+		}
+
+		credited := r.getCreditedEmails(r.newCommit(commit))
+		if len(credited) == 0 {
+			continue
+		}
+
+		var conventional *ConventionalCommit
+		if parsed, ok := ParseConventionalCommit(commit.Message); ok {
+			conventional = &parsed
+		}
+
+		conventions.Repository.add(conventional)
+
+		month := commit.Author.When.Format(monthLayout)
+		monthBreakdown := conventions.Months[month]
+		monthBreakdown.add(conventional)
+		conventions.Months[month] = monthBreakdown
+
+		for _, email := range credited {
+			authorBreakdown := conventions.Authors[email]
+			authorBreakdown.add(conventional)
+			conventions.Authors[email] = authorBreakdown
+		}
+	}
+
+	return conventions
+}
+
+// sortedByCount returns the keys of counts ordered by the highest to the lowest count, then by key.
+func sortedByCount(counts map[string]int) []string {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+
+	return keys
+}
+
+// GetConventions returns the Conventional Commits breakdown of the repository, of each author and of each month.
+func (r *RepoReader) GetConventions() (Conventions, error) {
+	commits, err := r.getCommits()
+	if err != nil {
+		return Conventions{}, fmt.Errorf("GetConventions: unable to get the repository commits: %w", err)
+	}
+
+	return r.getConventions(commits), nil
+}
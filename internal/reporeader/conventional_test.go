@@ -0,0 +1,119 @@
+package reporeader_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/djyuhn/gitcha/gittest"
+	"github.com/djyuhn/gitcha/internal/reporeader"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConventionalCommit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given subject with type, scope and breaking flag should parse every part", func(t *testing.T) {
+		t.Parallel()
+
+		actual, ok := reporeader.ParseConventionalCommit("Feat(parser)!: support arrays\n\nDetails.")
+
+		assert.True(t, ok)
+		assert.Equal(t, reporeader.ConventionalCommit{Type: "feat", Scope: "parser", Breaking: true, Description: "support arrays"}, actual)
+	})
+
+	t.Run("given BREAKING CHANGE footer should flag the commit as breaking", func(t *testing.T) {
+		t.Parallel()
+
+		actual, ok := reporeader.ParseConventionalCommit("fix: drop legacy flag\n\nBREAKING CHANGE: the --legacy flag is removed")
+
+		assert.True(t, ok)
+		assert.Equal(t, "fix", actual.Type)
+		assert.Empty(t, actual.Scope)
+		assert.True(t, actual.Breaking)
+	})
+
+	t.Run("given non-conforming subjects should return false", func(t *testing.T) {
+		t.Parallel()
+
+		for _, message := range []string{"Fix the parser", "feat:missing space", "feat(): ", "Merge branch 'main'"} {
+			_, ok := reporeader.ParseConventionalCommit(message)
+
+			assert.False(t, ok, message)
+		}
+	})
+}
+
+func TestRepoReader_GetConventions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given commits should break conventions down by type, scope, author and month", func(t *testing.T) {
+		t.Parallel()
+
+		january := time.Date(2023, time.January, 20, 9, 0, 0, 0, time.UTC)
+		february := time.Date(2023, time.February, 3, 9, 0, 0, 0, time.UTC)
+		authorOne := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: january}
+		authorTwo := object.Signature{Name: "Gitcha Two", Email: "gitcha2@gitcha.com", When: february}
+
+		commits := []gittest.LocalCommit{
+			{Author: authorOne, Message: "feat(parser): add arrays", Files: map[string]string{"a.txt": "a\n"}},
+			{Author: authorOne, Message: "Fix things", Files: map[string]string{"b.txt": "b\n"}},
+			{Author: authorTwo, Message: "fix(parser)!: reject trailing commas", Files: map[string]string{"c.txt": "c\n"}},
+			{Author: authorTwo, Message: "feat: add cli", Files: map[string]string{"d.txt": "d\n"}},
+		}
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetConventions()
+		require.NoError(t, err)
+
+		repository := actual.Repository
+		assert.Equal(t, 4, repository.Commits)
+		assert.Equal(t, 3, repository.Conforming)
+		assert.Equal(t, 1, repository.Breaking)
+		assert.Equal(t, 25.0, repository.NonConformingShare())
+		assert.Equal(t, []string{"feat", "fix"}, repository.SortedTypes())
+		assert.Equal(t, map[string]int{"parser": 2}, repository.Scopes)
+
+		assert.Equal(t, map[string]int{"feat": 1}, actual.Authors[authorOne.Email].Types)
+		assert.Equal(t, 50.0, actual.Authors[authorOne.Email].NonConformingShare())
+		assert.Equal(t, map[string]int{"feat": 1, "fix": 1}, actual.Authors[authorTwo.Email].Types)
+
+		assert.Equal(t, []string{"2023-01", "2023-02"}, actual.SortedMonths())
+		assert.Equal(t, 2, actual.Months["2023-02"].Conforming)
+	})
+
+	t.Run("given merge commits should leave them out of the breakdowns", func(t *testing.T) {
+		t.Parallel()
+
+		january := time.Date(2023, time.January, 20, 9, 0, 0, 0, time.UTC)
+		march := time.Date(2023, time.March, 1, 9, 0, 0, 0, time.UTC)
+		author := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: january}
+		merger := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: march}
+
+		commits := []gittest.LocalCommit{
+			{Author: author, Message: "feat: add arrays", Files: map[string]string{"a.txt": "a\n"}},
+			{Author: author, Message: "fix: reject commas", Files: map[string]string{"b.txt": "b\n"}, Parents: []int{0}},
+			{Author: author, Message: "Fix things", Files: map[string]string{"c.txt": "c\n"}, Parents: []int{0}},
+			{Author: merger, Message: "Merge pull request #12 from gitcha/feature", Parents: []int{2, 1}},
+		}
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetConventions()
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, actual.Repository.Commits)
+		assert.InDelta(t, 100.0/3, actual.Repository.NonConformingShare(), 0.01)
+		assert.InDelta(t, 100.0/3, actual.Authors[author.Email].NonConformingShare(), 0.01)
+		assert.Equal(t, []string{"2023-01"}, actual.SortedMonths())
+	})
+}
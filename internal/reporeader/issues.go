@@ -0,0 +1,177 @@
+package reporeader
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// DefaultIssuePatterns are the patterns matching issue references by default: GitHub and GitLab style numbers such as
+// #123. Jira style keys such as JIRA-456 are only matched with a pattern for the project key, such as `JIRA-\d+`, since
+// a pattern for any key also matches identifiers such as UTF-8, SHA-256 and CVE-2023.
+var DefaultIssuePatterns = []string{
+	`(?:^|[^\w&/])(#\d+)\b`,
+}
+
+// defaultIssuePatterns are the compiled DefaultIssuePatterns.
+var defaultIssuePatterns = mustCompileAll(DefaultIssuePatterns)
+
+// ReferenceRate counts the commits of an author and how many of them reference an issue.
+type ReferenceRate struct {
+	Commits     int `json:"commits"`
+	Referencing int `json:"referencing"`
+}
+
+// Share returns the percentage of commits that reference an issue.
+func (r ReferenceRate) Share() float64 {
+	if r.Commits == 0 {
+		return 0
+	}
+
+	return float64(r.Referencing) * percent / float64(r.Commits)
+}
+
+// IssueReferences audits the issue references in commit messages.
+//
+// Issues holds the hashes of the commits referencing every issue and Unreferenced the commits referencing none, both
+// in the order of the commits. Authors holds the reference rate of every commit author by email.
+type IssueReferences struct {
+	ReferenceRate
+	Issues       map[string][]string      `json:"issues"`
+	Unreferenced []Commit                 `json:"unreferenced"`
+	Authors      map[string]ReferenceRate `json:"authors"`
+}
+
+// SortedIssues returns the referenced issues ordered by name.
+func (i IssueReferences) SortedIssues() []string {
+	issues := make([]string, 0, len(i.Issues))
+	for issue := range i.Issues {
+		issues = append(issues, issue)
+	}
+	sort.Strings(issues)
+
+	return issues
+}
+
+// SortedAuthors returns the emails of the commit authors from the lowest to the highest reference rate, then by
+// email, so the authors most often leaving out references come first.
+func (i IssueReferences) SortedAuthors() []string {
+	authors := make([]string, 0, len(i.Authors))
+	for email := range i.Authors {
+		authors = append(authors, email)
+	}
+
+	sort.Slice(authors, func(a, b int) bool {
+		shareA, shareB := i.Authors[authors[a]].Share(), i.Authors[authors[b]].Share()
+		if shareA != shareB {
+			return shareA < shareB
+		}
+		return authors[a] < authors[b]
+	})
+
+	return authors
+}
+
+// ParseIssuePatterns compiles patterns matching issue references or returns an error for the first invalid pattern.
+func ParseIssuePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("ParseIssuePatterns: invalid issue pattern %q: %w", pattern, err)
+		}
+		compiled = append(compiled, re)
+	}
+
+	return compiled, nil
+}
+
+func mustCompileAll(patterns []string) []*regexp.Regexp {
+	compiled, err := ParseIssuePatterns(patterns)
+	if err != nil {
+		panic(err)
+	}
+
+	return compiled
+}
+
+// parseIssueReferences returns the issues referenced by message in the order they first appear. A pattern with a
+// capture group references the text of its first group and any other pattern the text of its whole match.
+func (r *RepoReader) parseIssueReferences(message string) []string {
+	seen := make(map[string]bool)
+	issues := make([]string, 0)
+
+	for _, pattern := range r.issuePatterns {
+		for _, match := range pattern.FindAllStringSubmatch(message, -1) {
+			issue := match[0]
+			if len(match) > 1 {
+				issue = match[1]
+			}
+			if issue == "" || seen[issue] {
+				continue
+			}
+			seen[issue] = true
+			issues = append(issues, issue)
+		}
+	}
+
+	return issues
+}
+
+// getIssueReferences audits the issue references of commits.
+func (r *RepoReader) getIssueReferences(commits []Commit) IssueReferences {
+	references := IssueReferences{
+		Issues:       make(map[string][]string),
+		Unreferenced: make([]Commit, 0),
+		Authors:      make(map[string]ReferenceRate),
+	}
+
+	for _, commit := range commits {
+		issues := r.parseIssueReferences(commit.Message)
+
+		author := references.Authors[commit.Author.Email]
+		author.Commits++
+		references.Commits++
+
+		if len(issues) == 0 {
+			references.Unreferenced = append(references.Unreferenced, commit)
+		} else {
+			author.Referencing++
+			references.Referencing++
+		}
+		references.Authors[commit.Author.Email] = author
+
+		for _, issue := range issues {
+			references.Issues[issue] = append(references.Issues[issue], commit.Hash)
+		}
+	}
+
+	return references
+}
+
+// getAuditedCommits returns the commits whose issue references are audited: every commit other than merges and the
+// commits of bots left out of the analysis.
+func (r *RepoReader) getAuditedCommits(commits []*object.Commit) []Commit {
+	audited := make([]Commit, 0, len(commits))
+	for _, commit := range commits {
+		if commit.NumParents() > 1 || r.excludesBot(commit.Author.Name, commit.Author.Email) {
+			continue
+		}
+		audited = append(audited, r.newCommit(commit))
+	}
+
+	return audited
+}
+
+// GetIssueReferences audits the issue references of the commits in a revision range, as understood by
+// GetCommitRange.
+func (r *RepoReader) GetIssueReferences(from, to string) (IssueReferences, error) {
+	commits, err := r.GetCommitRange(from, to)
+	if err != nil {
+		return IssueReferences{}, fmt.Errorf("GetIssueReferences: %w", err)
+	}
+
+	return r.getIssueReferences(commits), nil
+}
@@ -0,0 +1,158 @@
+package reporeader_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/djyuhn/gitcha/gittest"
+	"github.com/djyuhn/gitcha/internal/reporeader"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoReader_GetIssueReferences(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+	authorOne := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: start}
+	authorTwo := object.Signature{Name: "Gitcha Two", Email: "gitcha2@gitcha.com", When: start.Add(time.Hour)}
+
+	commits := []gittest.LocalCommit{
+		{Author: authorOne, Message: "Initial commit", Files: map[string]string{"README.md": "# gitcha\n"}},
+		{Author: authorTwo, Message: "Add the cache\n\nCloses #12", Files: map[string]string{"cache.go": "package cache\n"}},
+		{Author: authorOne, Message: "GITCHA-7 Evict stale entries (#12)", Files: map[string]string{"cache.go": "package cache\n// evict\n"}},
+		{Author: authorTwo, Message: "Escape &#39; in titles", Files: map[string]string{"title.go": "package title\n"}},
+		{Author: authorOne, Message: "GITCHA-8: Add the tree view", Files: map[string]string{"tree.go": "package tree\n"}},
+	}
+
+	t.Run("given commits with and without references should report issues and reference rates", func(t *testing.T) {
+		t.Parallel()
+
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+		hashes := commitHashesByMessage(t, repo)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetIssueReferences("", "")
+		require.NoError(t, err)
+
+		assert.Equal(t, 5, actual.Commits)
+		assert.Equal(t, 2, actual.Referencing)
+		assert.InDelta(t, 40, actual.Share(), 0.01)
+		assert.Equal(t, []string{"#12"}, actual.SortedIssues())
+		assert.Equal(t, []string{
+			hashes["GITCHA-7 Evict stale entries (#12)"].String(),
+			hashes["Add the cache\n\nCloses #12"].String(),
+		}, actual.Issues["#12"])
+
+		require.Len(t, actual.Unreferenced, 3)
+		assert.Equal(t, "GITCHA-8: Add the tree view", actual.Unreferenced[0].Message)
+		assert.Equal(t, "Escape &#39; in titles", actual.Unreferenced[1].Message)
+		assert.Equal(t, "Initial commit", actual.Unreferenced[2].Message)
+
+		assert.Equal(t, map[string]reporeader.ReferenceRate{
+			authorOne.Email: {Commits: 3, Referencing: 1},
+			authorTwo.Email: {Commits: 2, Referencing: 1},
+		}, actual.Authors)
+		assert.Equal(t, []string{authorOne.Email, authorTwo.Email}, actual.SortedAuthors())
+	})
+
+	t.Run("given a revision range should only audit the commits in the range", func(t *testing.T) {
+		t.Parallel()
+
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+		hashes := commitHashesByMessage(t, repo)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetIssueReferences(hashes["Initial commit"].String(), hashes["Escape &#39; in titles"].String())
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, actual.Commits)
+		assert.Equal(t, []string{"#12"}, actual.SortedIssues())
+		require.Len(t, actual.Unreferenced, 1)
+		assert.Equal(t, "Escape &#39; in titles", actual.Unreferenced[0].Message)
+	})
+
+	t.Run("given custom issue patterns should only match those patterns", func(t *testing.T) {
+		t.Parallel()
+
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		patterns, err := reporeader.ParseIssuePatterns([]string{`GITCHA-\d+`})
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo, reporeader.WithIssuePatterns(patterns...))
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetIssueReferences("", "")
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, actual.Referencing)
+		assert.Equal(t, []string{"GITCHA-7", "GITCHA-8"}, actual.SortedIssues())
+	})
+
+	t.Run("given standard identifiers should not match them with the default patterns", func(t *testing.T) {
+		t.Parallel()
+
+		identifiers := []gittest.LocalCommit{
+			{Author: authorOne, Message: "Read files as UTF-8", Files: map[string]string{"read.go": "package read\n"}},
+			{Author: authorOne, Message: "Verify SHA-256 checksums", Files: map[string]string{"sum.go": "package sum\n"}},
+			{Author: authorOne, Message: "Format dates as ISO-8601", Files: map[string]string{"date.go": "package date\n"}},
+			{Author: authorTwo, Message: "Patch CVE-2023-1234 and X-2 headers", Files: map[string]string{"http.go": "package http\n"}},
+		}
+		_, repo, err := gittest.CreateLocalRepo(t, identifiers)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetIssueReferences("", "")
+		require.NoError(t, err)
+
+		assert.Equal(t, 4, actual.Commits)
+		assert.Zero(t, actual.Referencing)
+		assert.Empty(t, actual.Issues)
+	})
+
+	t.Run("given an unknown revision should return an error", func(t *testing.T) {
+		t.Parallel()
+
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		_, err = repoReader.GetIssueReferences("v9.9.9", "")
+		assert.ErrorContains(t, err, "GetIssueReferences:")
+	})
+}
+
+func TestParseIssuePatterns(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given valid patterns should compile them in order", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := reporeader.ParseIssuePatterns([]string{`#(\d+)`, `[A-Z]+-\d+`})
+		require.NoError(t, err)
+
+		assert.Equal(t, []*regexp.Regexp{regexp.MustCompile(`#(\d+)`), regexp.MustCompile(`[A-Z]+-\d+`)}, actual)
+	})
+
+	t.Run("given an invalid pattern should return an error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := reporeader.ParseIssuePatterns([]string{`#(\d+`})
+		assert.ErrorContains(t, err, "invalid issue pattern")
+	})
+}
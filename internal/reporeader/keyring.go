@@ -0,0 +1,234 @@
+package reporeader
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	pgperrors "github.com/ProtonMail/go-crypto/openpgp/errors"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	// pgpPublicKeyHeader starts an armored OpenPGP public key block, as exported by gpg --armor --export.
+	pgpPublicKeyHeader = "-----BEGIN PGP PUBLIC KEY BLOCK-----"
+
+	// sshSignatureMagic starts every SSH signature and the data it signs.
+	sshSignatureMagic = "SSHSIG"
+	// sshSignatureVersion is the only version of the SSH signature format.
+	sshSignatureVersion = 1
+	// sshGitNamespace is the namespace git signs commits in, so a signature made for another purpose is not accepted
+	// for a commit.
+	sshGitNamespace = "git"
+)
+
+var (
+	// errUnknownKey is returned when a signature is made by a key that is not in the keyring.
+	errUnknownKey = errors.New("signed by a key outside the keyring")
+	// errNoKeys is returned when the keyring holds no keys of the type needed to check a signature.
+	errNoKeys = errors.New("no keys to check the signature with")
+)
+
+// Keyring holds the public keys commit signatures are verified against: OpenPGP keys for GPG signatures and the
+// allowed signers of SSH signatures.
+type Keyring struct {
+	pgp openpgp.EntityList
+	ssh []allowedSigner
+}
+
+// allowedSigner is a line of an SSH allowed signers file: the principals, usually emails, allowed to sign with a key.
+type allowedSigner struct {
+	principals []string
+	key        ssh.PublicKey
+}
+
+// Empty returns whether the keyring holds no keys, in which case signatures are detected but not verified.
+func (k Keyring) Empty() bool {
+	return len(k.pgp) == 0 && len(k.ssh) == 0
+}
+
+// ReadKeyring reads the keys of keyring files. Each file is either an armored OpenPGP public keyring, as exported by
+// gpg --armor --export, or an SSH allowed signers file as used by git's gpg.ssh.allowedSignersFile.
+func ReadKeyring(paths ...string) (Keyring, error) {
+	keyring := Keyring{}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return Keyring{}, fmt.Errorf("ReadKeyring: unable to read keyring %s: %w", path, err)
+		}
+
+		parsed, err := ParseKeyring(data)
+		if err != nil {
+			return Keyring{}, fmt.Errorf("ReadKeyring: invalid keyring %s: %w", path, err)
+		}
+		keyring.pgp = append(keyring.pgp, parsed.pgp...)
+		keyring.ssh = append(keyring.ssh, parsed.ssh...)
+	}
+
+	return keyring, nil
+}
+
+// ParseKeyring parses an armored OpenPGP public keyring or an SSH allowed signers file.
+func ParseKeyring(data []byte) (Keyring, error) {
+	if bytes.Contains(data, []byte(pgpPublicKeyHeader)) {
+		entities, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(data))
+		if err != nil {
+			return Keyring{}, fmt.Errorf("ParseKeyring: invalid OpenPGP keyring: %w", err)
+		}
+		return Keyring{pgp: entities}, nil
+	}
+
+	signers, err := parseAllowedSigners(data)
+	if err != nil {
+		return Keyring{}, fmt.Errorf("ParseKeyring: %w", err)
+	}
+
+	return Keyring{ssh: signers}, nil
+}
+
+// parseAllowedSigners parses the lines of an SSH allowed signers file: comma separated principals followed by
+// optional options and the public key, as in "alice@example.com ssh-ed25519 AAAA...". Blank lines and comments are
+// skipped.
+func parseAllowedSigners(data []byte) ([]allowedSigner, error) {
+	signers := make([]allowedSigner, 0)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for number := 1; scanner.Scan(); number++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		principals, key, found := strings.Cut(line, " ")
+		if !found {
+			return nil, fmt.Errorf("invalid allowed signer on line %d: missing public key", number)
+		}
+
+		publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(strings.TrimSpace(key)))
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed signer on line %d: %w", number, err)
+		}
+
+		signers = append(signers, allowedSigner{principals: strings.Split(principals, ","), key: publicKey})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read allowed signers: %w", err)
+	}
+
+	return signers, nil
+}
+
+// verifyPGP checks an armored OpenPGP signature of payload and returns the identity of the signing key.
+func (k Keyring) verifyPGP(payload []byte, signature string) (string, error) {
+	if len(k.pgp) == 0 {
+		return "", errNoKeys
+	}
+
+	entity, err := openpgp.CheckArmoredDetachedSignature(k.pgp, bytes.NewReader(payload), strings.NewReader(signature), nil)
+	if err != nil {
+		if errors.Is(err, pgperrors.ErrUnknownIssuer) {
+			return "", errUnknownKey
+		}
+		return "", err
+	}
+
+	if identity := entity.PrimaryIdentity(); identity != nil {
+		return identity.Name, nil
+	}
+
+	return entity.PrimaryKey.KeyIdString(), nil
+}
+
+// sshSignature is an SSH signature following the magic preamble, as described in OpenSSH's PROTOCOL.sshsig.
+type sshSignature struct {
+	Version       uint32
+	PublicKey     []byte
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Signature     []byte
+}
+
+// sshSignedData is the data an SSH signature is made over, following the magic preamble.
+type sshSignedData struct {
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Hash          []byte
+}
+
+// verifySSH checks an armored SSH signature of payload made in the git namespace and returns the principals allowed
+// to sign with its key.
+func (k Keyring) verifySSH(payload []byte, signature string) (string, error) {
+	if len(k.ssh) == 0 {
+		return "", errNoKeys
+	}
+
+	block, _ := pem.Decode([]byte(signature))
+	if block == nil || !bytes.HasPrefix(block.Bytes, []byte(sshSignatureMagic)) {
+		return "", errors.New("invalid SSH signature")
+	}
+
+	var parsed sshSignature
+	if err := ssh.Unmarshal(block.Bytes[len(sshSignatureMagic):], &parsed); err != nil {
+		return "", fmt.Errorf("invalid SSH signature: %w", err)
+	}
+	if parsed.Version != sshSignatureVersion {
+		return "", fmt.Errorf("unsupported SSH signature version %d", parsed.Version)
+	}
+	if parsed.Namespace != sshGitNamespace {
+		return "", fmt.Errorf("SSH signature made for the %q namespace", parsed.Namespace)
+	}
+
+	publicKey, err := ssh.ParsePublicKey(parsed.PublicKey)
+	if err != nil {
+		return "", fmt.Errorf("invalid SSH signature key: %w", err)
+	}
+
+	var signer *allowedSigner
+	for i := range k.ssh {
+		if bytes.Equal(k.ssh[i].key.Marshal(), publicKey.Marshal()) {
+			signer = &k.ssh[i]
+			break
+		}
+	}
+	if signer == nil {
+		return "", errUnknownKey
+	}
+
+	var digest []byte
+	switch parsed.HashAlgorithm {
+	case "sha256":
+		sum := sha256.Sum256(payload)
+		digest = sum[:]
+	case "sha512":
+		sum := sha512.Sum512(payload)
+		digest = sum[:]
+	default:
+		return "", fmt.Errorf("unsupported SSH signature hash %q", parsed.HashAlgorithm)
+	}
+
+	var sig ssh.Signature
+	if err := ssh.Unmarshal(parsed.Signature, &sig); err != nil {
+		return "", fmt.Errorf("invalid SSH signature: %w", err)
+	}
+
+	signed := append([]byte(sshSignatureMagic), ssh.Marshal(sshSignedData{
+		Namespace:     parsed.Namespace,
+		Reserved:      parsed.Reserved,
+		HashAlgorithm: parsed.HashAlgorithm,
+		Hash:          digest,
+	})...)
+	if err := publicKey.Verify(signed, &sig); err != nil {
+		return "", err
+	}
+
+	return strings.Join(signer.principals, ","), nil
+}
@@ -0,0 +1,47 @@
+package reporeader_test
+
+import (
+	"testing"
+
+	"github.com/djyuhn/gitcha/internal/reporeader"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKeyring(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given an empty allowed signers file should return an empty keyring", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := reporeader.ParseKeyring([]byte("# no signers yet\n\n"))
+		require.NoError(t, err)
+
+		assert.True(t, actual.Empty())
+	})
+
+	t.Run("given an invalid allowed signer should return error with its line", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := reporeader.ParseKeyring([]byte("# signers\ngitcha1@gitcha.com ssh-ed25519 invalid\n"))
+
+		assert.ErrorContains(t, err, "line 2")
+	})
+
+	t.Run("given an invalid OpenPGP keyring should return error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := reporeader.ParseKeyring([]byte("-----BEGIN PGP PUBLIC KEY BLOCK-----\n\ninvalid\n"))
+
+		assert.ErrorContains(t, err, "invalid OpenPGP keyring")
+	})
+
+	t.Run("given a missing keyring file should return error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := reporeader.ReadKeyring(t.TempDir() + "/missing")
+
+		assert.ErrorContains(t, err, "unable to read keyring")
+	})
+}
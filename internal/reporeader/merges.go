@@ -0,0 +1,271 @@
+package reporeader
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+const (
+	// weekLayout formats the Monday starting the week a pull request was merged in.
+	weekLayout = "2006-01-02"
+
+	daysPerWeek = 7
+)
+
+var (
+	// githubMergePattern matches the subject of a GitHub merge commit, such as "Merge pull request #12 from user/branch".
+	githubMergePattern = regexp.MustCompile(`^Merge pull request #(\d+) from (\S+)`)
+	// bitbucketMergePattern matches the subject of a Bitbucket merge commit, such as
+	// "Merged in branch (pull request #12)".
+	bitbucketMergePattern = regexp.MustCompile(`^Merged in (\S+) \(pull request #(\d+)\)`)
+	// gitlabMergePattern matches the line closing a GitLab merge commit message, such as "See merge request group/project!12".
+	gitlabMergePattern = regexp.MustCompile(`(?m)^See merge request \S*!(\d+)\s*$`)
+	// gitlabBranchPattern matches the subject of a GitLab merge commit, such as "Merge branch 'feature' into 'main'".
+	gitlabBranchPattern = regexp.MustCompile(`^Merge branch '([^']+)'`)
+	// squashPattern matches the number GitHub appends to the subject of squashed and rebased pull requests, such as
+	// "Add the tree view (#12)".
+	squashPattern = regexp.MustCompile(`\(#(\d+)\)$`)
+)
+
+// PullRequest is a pull or merge request identified from the commit that merged it into the checked out branch.
+//
+// The commits of a pull request merged with a merge commit are the commits reachable from the merged parents that
+// were not yet on the branch. A squashed pull request is a single commit whose branch commits are unknown, so its
+// lead time from the first commit to the merge is unknown too and left at zero.
+type PullRequest struct {
+	Number          int           `json:"number"`
+	Hash            string        `json:"hash"`
+	Source          string        `json:"source,omitempty"`
+	Squashed        bool          `json:"squashed"`
+	Author          Author        `json:"author"`
+	Commits         int           `json:"commits"`
+	FirstCommitDate time.Time     `json:"firstCommitDate"`
+	MergedDate      time.Time     `json:"mergedDate"`
+	LeadTime        time.Duration `json:"leadTime"`
+}
+
+// Merges summarizes the merge commits and pull requests merged into the checked out branch, following its first
+// parents.
+//
+// Pull requests are ordered from the oldest to the newest merge and credited to the author of their first commit.
+// Authors counts the pull requests of every author by email and Weekly the pull requests merged every week, keyed by
+// the Monday starting the week. The lead times only cover pull requests merged with a merge commit.
+type Merges struct {
+	MergeCommits    int            `json:"mergeCommits"`
+	PullRequests    []PullRequest  `json:"pullRequests"`
+	Authors         map[string]int `json:"authors"`
+	Weekly          map[string]int `json:"weekly"`
+	AverageLeadTime time.Duration  `json:"averageLeadTime"`
+	MedianLeadTime  time.Duration  `json:"medianLeadTime"`
+}
+
+// SortedWeeks returns the weeks with merged pull requests from the oldest to the newest.
+func (m Merges) SortedWeeks() []string {
+	weeks := make([]string, 0, len(m.Weekly))
+	for week := range m.Weekly {
+		weeks = append(weeks, week)
+	}
+	sort.Strings(weeks)
+
+	return weeks
+}
+
+// SortedAuthors returns the emails of the authors of pull requests from the most to the fewest pull requests.
+func (m Merges) SortedAuthors() []string {
+	return sortedByCount(m.Authors)
+}
+
+// pullRequestReference is what the message of a commit tells about the pull request it merged.
+type pullRequestReference struct {
+	number   int
+	source   string
+	squashed bool
+}
+
+// parsePullRequestReference identifies the pull request merged by a commit from its message. Merge commits are
+// recognized by the GitHub, GitLab and Bitbucket merge messages and other commits by a "(#N)" suffix to their subject.
+func parsePullRequestReference(message string, merge bool) (pullRequestReference, bool) {
+	subject, _, _ := strings.Cut(message, "\n")
+	subject = strings.TrimSpace(subject)
+
+	if !merge {
+		if match := squashPattern.FindStringSubmatch(subject); match != nil {
+			return newPullRequestReference(match[1], "", true)
+		}
+		return pullRequestReference{}, false
+	}
+
+	if match := githubMergePattern.FindStringSubmatch(subject); match != nil {
+		return newPullRequestReference(match[1], match[2], false)
+	}
+	if match := bitbucketMergePattern.FindStringSubmatch(subject); match != nil {
+		return newPullRequestReference(match[2], match[1], false)
+	}
+	if match := gitlabMergePattern.FindStringSubmatch(message); match != nil {
+		source := ""
+		if branch := gitlabBranchPattern.FindStringSubmatch(subject); branch != nil {
+			source = branch[1]
+		}
+		return newPullRequestReference(match[1], source, false)
+	}
+
+	return pullRequestReference{}, false
+}
+
+func newPullRequestReference(number, source string, squashed bool) (pullRequestReference, bool) {
+	n, err := strconv.Atoi(number)
+	if err != nil {
+		return pullRequestReference{}, false
+	}
+
+	return pullRequestReference{number: n, source: source, squashed: squashed}, true
+}
+
+// getMerges walks the first parents of HEAD from the oldest commit, keeping the set of commits already on the branch
+// so the commits brought in by every merge are found in a single pass over the history.
+func (r *RepoReader) getMerges(commits []*object.Commit) (Merges, error) {
+	merges := Merges{PullRequests: make([]PullRequest, 0), Authors: make(map[string]int), Weekly: make(map[string]int)}
+	if len(commits) == 0 {
+		return merges, nil
+	}
+
+	head, err := r.repository.Head()
+	if err != nil {
+		return Merges{}, fmt.Errorf("getMerges: unable to get the repository head: %w", err)
+	}
+
+	byHash := make(map[plumbing.Hash]*object.Commit, len(commits))
+	for _, commit := range commits {
+		byHash[commit.Hash] = commit
+	}
+
+	mainline := make([]*object.Commit, 0)
+	for commit, ok := byHash[head.Hash()]; ok; {
+		mainline = append(mainline, commit)
+		if commit.NumParents() == 0 {
+			break
+		}
+		commit, ok = byHash[commit.ParentHashes[0]]
+	}
+
+	onBranch := make(map[plumbing.Hash]bool, len(commits))
+	leadTimes := make([]time.Duration, 0)
+	for i := len(mainline) - 1; i >= 0; i-- {
+		commit := mainline[i]
+		merged := mergedCommits(commit, byHash, onBranch)
+		onBranch[commit.Hash] = true
+
+		isMerge := commit.NumParents() > 1
+		if isMerge {
+			merges.MergeCommits++
+		}
+
+		reference, ok := parsePullRequestReference(commit.Message, isMerge)
+		if !ok {
+			continue
+		}
+
+		pullRequest := newPullRequest(commit, reference, merged)
+		if r.excludesBot(pullRequest.Author.Name, pullRequest.Author.Email) {
+			continue
+		}
+
+		merges.PullRequests = append(merges.PullRequests, pullRequest)
+		merges.Authors[pullRequest.Author.Email]++
+		merges.Weekly[weekStart(pullRequest.MergedDate).Format(weekLayout)]++
+		if !pullRequest.Squashed {
+			leadTimes = append(leadTimes, pullRequest.LeadTime)
+		}
+	}
+
+	merges.AverageLeadTime, merges.MedianLeadTime = averageAndMedian(leadTimes)
+
+	return merges, nil
+}
+
+// mergedCommits returns the commits reachable from the parents of commit other than the first that are not on the
+// branch yet, marking them as on the branch.
+func mergedCommits(commit *object.Commit, byHash map[plumbing.Hash]*object.Commit, onBranch map[plumbing.Hash]bool) []*object.Commit {
+	merged := make([]*object.Commit, 0)
+	if commit.NumParents() <= 1 {
+		return merged
+	}
+
+	stack := append(make([]plumbing.Hash, 0, commit.NumParents()-1), commit.ParentHashes[1:]...)
+	for len(stack) > 0 {
+		hash := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		parent, ok := byHash[hash]
+		if !ok || onBranch[hash] {
+			continue
+		}
+		onBranch[hash] = true
+		merged = append(merged, parent)
+		stack = append(stack, parent.ParentHashes...)
+	}
+
+	return merged
+}
+
+// newPullRequest describes the pull request merged by commit along with the commits it brought in.
+func newPullRequest(commit *object.Commit, reference pullRequestReference, merged []*object.Commit) PullRequest {
+	pullRequest := PullRequest{
+		Number:          reference.number,
+		Hash:            commit.Hash.String(),
+		Source:          reference.source,
+		Squashed:        reference.squashed,
+		Author:          Author{Name: commit.Author.Name, Email: commit.Author.Email},
+		Commits:         1,
+		FirstCommitDate: commit.Author.When,
+		MergedDate:      commit.Committer.When,
+	}
+
+	if reference.squashed || len(merged) == 0 {
+		return pullRequest
+	}
+
+	first := merged[0]
+	for _, c := range merged[1:] {
+		if c.Author.When.Before(first.Author.When) {
+			first = c
+		}
+	}
+
+	pullRequest.Author = Author{Name: first.Author.Name, Email: first.Author.Email}
+	pullRequest.Commits = len(merged)
+	pullRequest.FirstCommitDate = first.Author.When
+	pullRequest.LeadTime = pullRequest.MergedDate.Sub(pullRequest.FirstCommitDate)
+
+	return pullRequest
+}
+
+// weekStart returns the start of the Monday of the week containing t, in the location of t.
+func weekStart(t time.Time) time.Time {
+	offset := (int(t.Weekday()-time.Monday) + daysPerWeek) % daysPerWeek
+	year, month, day := t.AddDate(0, 0, -offset).Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+// GetMerges returns the merge commits and pull requests merged into the checked out branch.
+func (r *RepoReader) GetMerges() (Merges, error) {
+	commits, err := r.getCommits()
+	if err != nil {
+		return Merges{}, fmt.Errorf("GetMerges: %w", err)
+	}
+
+	merges, err := r.getMerges(commits)
+	if err != nil {
+		return Merges{}, fmt.Errorf("GetMerges: %w", err)
+	}
+
+	return merges, nil
+}
@@ -0,0 +1,118 @@
+package reporeader_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/djyuhn/gitcha/gittest"
+	"github.com/djyuhn/gitcha/internal/reporeader"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoReader_GetMerges(t *testing.T) {
+	t.Parallel()
+
+	// 2023-01-02 is a Monday.
+	start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	authors := map[int]object.Signature{
+		1: {Name: "Gitcha One", Email: "gitcha1@gitcha.com"},
+		2: {Name: "Gitcha Two", Email: "gitcha2@gitcha.com"},
+		3: {Name: "Gitcha Three", Email: "gitcha3@gitcha.com"},
+		4: {Name: "dependabot[bot]", Email: "49699333+dependabot[bot]@users.noreply.github.com"},
+	}
+	signature := func(author int, offset time.Duration) object.Signature {
+		s := authors[author]
+		s.When = start.Add(offset)
+		return s
+	}
+
+	commits := []gittest.LocalCommit{
+		{Author: signature(1, 0), Message: "Initial commit", Files: map[string]string{"a.txt": "a\n"}},
+		{Author: signature(2, day), Message: "Start feature", Files: map[string]string{"feature.txt": "1\n"}, Parents: []int{0}},
+		{Author: signature(2, 2*day), Message: "Finish feature", Files: map[string]string{"feature.txt": "2\n"}, Parents: []int{1}},
+		{Author: signature(1, 2*day), Message: "Work on main", Files: map[string]string{"b.txt": "b\n"}, Parents: []int{0}},
+		{
+			Author:  signature(1, 3*day),
+			Message: "Merge pull request #12 from gitcha/feature\n\nAdd the feature",
+			Parents: []int{3, 2},
+		},
+		{Author: signature(3, 10*day), Message: "Add the thing (#13)", Files: map[string]string{"c.txt": "c\n"}, Parents: []int{4}},
+		{Author: signature(3, 11*day), Message: "Fix the thing", Files: map[string]string{"c.txt": "fixed\n"}, Parents: []int{5}},
+		{
+			Author:  signature(1, 12*day),
+			Message: "Merge branch 'fix' into 'main'\n\nFix the thing\n\nSee merge request group/gitcha!7",
+			Parents: []int{5, 6},
+		},
+		{Author: signature(1, 13*day), Message: "Merge branch 'main' into 'release'", Files: map[string]string{"d.txt": "d\n"}, Parents: []int{7}},
+	}
+
+	t.Run("given merge and squash commits should return pull requests with authors, lead times and weekly merges", func(t *testing.T) {
+		t.Parallel()
+
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetMerges()
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, actual.MergeCommits)
+		require.Len(t, actual.PullRequests, 3)
+
+		github := actual.PullRequests[0]
+		assert.Equal(t, 12, github.Number)
+		assert.Equal(t, "gitcha/feature", github.Source)
+		assert.False(t, github.Squashed)
+		assert.Equal(t, reporeader.Author{Name: "Gitcha Two", Email: "gitcha2@gitcha.com"}, github.Author)
+		assert.Equal(t, 2, github.Commits)
+		assert.Equal(t, 2*day, github.LeadTime)
+
+		squashed := actual.PullRequests[1]
+		assert.Equal(t, 13, squashed.Number)
+		assert.True(t, squashed.Squashed)
+		assert.Equal(t, "gitcha3@gitcha.com", squashed.Author.Email)
+		assert.Equal(t, 1, squashed.Commits)
+		assert.Zero(t, squashed.LeadTime)
+
+		gitlab := actual.PullRequests[2]
+		assert.Equal(t, 7, gitlab.Number)
+		assert.Equal(t, "fix", gitlab.Source)
+		assert.Equal(t, "gitcha3@gitcha.com", gitlab.Author.Email)
+		assert.Equal(t, 1, gitlab.Commits)
+		assert.Equal(t, day, gitlab.LeadTime)
+
+		assert.Equal(t, map[string]int{"gitcha2@gitcha.com": 1, "gitcha3@gitcha.com": 2}, actual.Authors)
+		assert.Equal(t, []string{"gitcha3@gitcha.com", "gitcha2@gitcha.com"}, actual.SortedAuthors())
+		assert.Equal(t, map[string]int{"2023-01-02": 1, "2023-01-09": 2}, actual.Weekly)
+		assert.Equal(t, []string{"2023-01-02", "2023-01-09"}, actual.SortedWeeks())
+		assert.Equal(t, 36*time.Hour, actual.AverageLeadTime)
+		assert.Equal(t, 36*time.Hour, actual.MedianLeadTime)
+	})
+
+	t.Run("given excluded bot pull requests should leave them out", func(t *testing.T) {
+		t.Parallel()
+
+		botCommits := []gittest.LocalCommit{
+			{Author: signature(1, 0), Message: "Initial commit", Files: map[string]string{"a.txt": "a\n"}},
+			{Author: signature(4, day), Message: "Bump gitcha (#3)", Files: map[string]string{"go.mod": "module gitcha\n"}},
+		}
+
+		_, repo, err := gittest.CreateLocalRepo(t, botCommits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo, reporeader.WithBotMode(reporeader.BotsExclude))
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetMerges()
+		require.NoError(t, err)
+
+		assert.Empty(t, actual.PullRequests)
+		assert.Empty(t, actual.Authors)
+	})
+}
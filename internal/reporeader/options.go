@@ -0,0 +1,214 @@
+package reporeader
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+const (
+	// DefaultSimilarity is the default similarity, as a percentage, two files need for a change to be detected as a
+	// rename or copy. It matches the git default.
+	DefaultSimilarity = 50
+
+	maxSimilarity = 100
+)
+
+// CreditMode is how commits are credited to the authors listed in their co-author trailers.
+type CreditMode string
+
+const (
+	// CreditShared credits a commit to its author and to every co-author.
+	CreditShared CreditMode = "shared"
+	// CreditPrimary credits a commit to its author alone.
+	CreditPrimary CreditMode = "primary"
+)
+
+// BotMode is how the activity of bot and automation accounts is treated.
+type BotMode string
+
+const (
+	// BotsInclude analyzes bots like any other author.
+	BotsInclude BotMode = "include"
+	// BotsExclude leaves the activity of bots out of the analysis.
+	BotsExclude BotMode = "exclude"
+	// BotsSeparate leaves the activity of bots out of the analysis and groups their commits separately.
+	BotsSeparate BotMode = "separate"
+)
+
+// Analysis is an analysis of the whole history slow enough on large repositories that GetRepoDetails defers it unless
+// enabled with WithAnalyses, leaving it to LoadAnalysis once its results are needed.
+type Analysis string
+
+const (
+	// AnalysisAuthorship replays the history to attribute every surviving line at HEAD to its author, for the bus
+	// factor, the ownership and the lines and contributors of the tree.
+	AnalysisAuthorship Analysis = "authorship"
+	// AnalysisHistories diffs every commit to collect the changes made to each file, for the file histories and the
+	// commits and last changes of the tree.
+	AnalysisHistories Analysis = "histories"
+	// AnalysisSize walks every tree of the history to measure the objects and find the largest blobs committed.
+	AnalysisSize Analysis = "size"
+)
+
+// Analyses are the analyses GetRepoDetails defers by default.
+var Analyses = []Analysis{AnalysisAuthorship, AnalysisHistories, AnalysisSize}
+
+// DefaultCoAuthorTrailers are the trailer keys that list the co-authors of a commit by default.
+var DefaultCoAuthorTrailers = []string{"Co-authored-by"}
+
+// ParseCreditMode returns the CreditMode matching mode or an error if the mode is not supported.
+func ParseCreditMode(mode string) (CreditMode, error) {
+	switch CreditMode(mode) {
+	case CreditShared, CreditPrimary:
+		return CreditMode(mode), nil
+	default:
+		return "", fmt.Errorf("ParseCreditMode: unsupported credit mode %q", mode)
+	}
+}
+
+// ParseBotMode returns the BotMode matching mode or an error if the mode is not supported.
+func ParseBotMode(mode string) (BotMode, error) {
+	switch BotMode(mode) {
+	case BotsInclude, BotsExclude, BotsSeparate:
+		return BotMode(mode), nil
+	default:
+		return "", fmt.Errorf("ParseBotMode: unsupported bot mode %q", mode)
+	}
+}
+
+// ParseAnalysis returns the Analysis matching analysis or an error if the analysis is not supported.
+func ParseAnalysis(analysis string) (Analysis, error) {
+	switch Analysis(analysis) {
+	case AnalysisAuthorship, AnalysisHistories, AnalysisSize:
+		return Analysis(analysis), nil
+	default:
+		return "", fmt.Errorf("ParseAnalysis: unsupported analysis %q", analysis)
+	}
+}
+
+// Option configures a RepoReader.
+type Option func(*RepoReader)
+
+// WithSimilarity sets the similarity, as a percentage, a deleted or modified file and an added file need to be
+// detected as a rename or copy. A similarity of 100 only detects exact renames and copies while a similarity of 0
+// disables rename and copy detection. Values above 100 are treated as 100.
+func WithSimilarity(similarity uint) Option {
+	return func(r *RepoReader) {
+		if similarity > maxSimilarity {
+			similarity = maxSimilarity
+		}
+		r.similarity = similarity
+	}
+}
+
+// WithCopyDetection sets whether added files are detected as copies of files modified by the same commit.
+func WithCopyDetection(enabled bool) Option {
+	return func(r *RepoReader) {
+		r.detectCopies = enabled
+	}
+}
+
+// WithCoAuthorTrailers sets the trailer keys, matched ignoring case, that list the co-authors of a commit as
+// "Name <email>". No trailers disables co-author detection.
+func WithCoAuthorTrailers(trailers ...string) Option {
+	return func(r *RepoReader) {
+		r.coAuthorTrailers = trailers
+	}
+}
+
+// WithCreditMode sets whether commits are credited to their co-authors as well as their author. Commits are credited
+// to their author alone by default, with their co-authors still listed on the commit.
+func WithCreditMode(mode CreditMode) Option {
+	return func(r *RepoReader) {
+		r.creditMode = mode
+	}
+}
+
+// WithBotMode sets whether the activity of bots is analyzed, left out or left out and grouped separately.
+func WithBotMode(mode BotMode) Option {
+	return func(r *RepoReader) {
+		r.botMode = mode
+	}
+}
+
+// WithBotPatterns adds patterns matched against author names and emails, ignoring case, to identify bots on top of
+// the known bot conventions. Patterns may use the wildcards of path.Match such as ci-*@example.com.
+func WithBotPatterns(patterns ...string) Option {
+	return func(r *RepoReader) {
+		r.botPatterns = patterns
+	}
+}
+
+// WithStaleAfter sets how long a branch can go without commits, relative to the most recent commit on any branch,
+// before it is considered stale. A threshold of zero disables stale detection.
+func WithStaleAfter(staleAfter time.Duration) Option {
+	return func(r *RepoReader) {
+		r.staleAfter = staleAfter
+	}
+}
+
+// WithIssuePatterns sets the patterns matching issue references in commit messages. A pattern with a capture group
+// references the text of its first group, such as #12 for (?:^|\s)(#\d+). No patterns disables issue detection.
+func WithIssuePatterns(patterns ...*regexp.Regexp) Option {
+	return func(r *RepoReader) {
+		r.issuePatterns = patterns
+	}
+}
+
+// WithKeyring sets the keys commit signatures are verified against. With an empty keyring signatures are detected but
+// not verified.
+func WithKeyring(keyring Keyring) Option {
+	return func(r *RepoReader) {
+		r.keyring = keyring
+	}
+}
+
+// WithProtectedBranches sets the branches whose unsigned commits are reported. Branches are looked up as local
+// branches, then as branches of origin, and missing ones are skipped. No branches protects the default branch.
+func WithProtectedBranches(branches ...string) Option {
+	return func(r *RepoReader) {
+		r.protectedBranches = branches
+	}
+}
+
+// WithLargeBlobCount sets the number of largest blobs reported. A negative count is treated as 0, so that both report
+// no blobs while the blobs of HEAD and of its history are still counted.
+func WithLargeBlobCount(count int) Option {
+	return func(r *RepoReader) {
+		if count < 0 {
+			count = 0
+		}
+		r.largeBlobCount = count
+	}
+}
+
+// WithAnalyses sets the analyses GetRepoDetails runs rather than defers. Every analysis is deferred by default.
+func WithAnalyses(analyses ...Analysis) Option {
+	return func(r *RepoReader) {
+		r.analyses = make(map[Analysis]bool, len(analyses))
+		for _, analysis := range analyses {
+			r.analyses[analysis] = true
+		}
+	}
+}
+
+// newRepoReader creates a RepoReader with the default configuration overridden by opts.
+func newRepoReader(opts []Option) *RepoReader {
+	r := &RepoReader{
+		similarity:       DefaultSimilarity,
+		detectCopies:     true,
+		coAuthorTrailers: DefaultCoAuthorTrailers,
+		creditMode:       CreditPrimary,
+		botMode:          BotsInclude,
+		staleAfter:       DefaultStaleAfter,
+		issuePatterns:    defaultIssuePatterns,
+		largeBlobCount:   DefaultLargeBlobCount,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
@@ -0,0 +1,130 @@
+package reporeader
+
+import (
+	"fmt"
+	"path"
+	"sort"
+)
+
+const percent = 100
+
+// AuthorOwnership holds the number of surviving lines attributed to an author by email and their share of the total
+// as a percentage.
+type AuthorOwnership struct {
+	Email   string  `json:"email"`
+	Lines   int     `json:"lines"`
+	Percent float64 `json:"percent"`
+}
+
+// PathOwnership holds the ownership of the surviving lines of a file or directory. Owners are ordered from the author
+// owning the most lines to the least.
+type PathOwnership struct {
+	Path   string            `json:"path"`
+	Lines  int               `json:"lines"`
+	Owners []AuthorOwnership `json:"owners"`
+}
+
+// Ownership holds the ownership of the surviving lines of the repository as a whole and of each file and directory,
+// ordered by path.
+type Ownership struct {
+	Repository  PathOwnership   `json:"repository"`
+	Files       []PathOwnership `json:"files"`
+	Directories []PathOwnership `json:"directories"`
+}
+
+// getOwnership aggregates the authorship of each file into ownership percentages per file, per directory and for the
+// repository.
+func (r *RepoReader) getOwnership(authorship map[string]FileAuthorship) Ownership {
+	repository := make(FileAuthorship)
+	directories := make(map[string]FileAuthorship)
+
+	ownership := Ownership{
+		Files:       make([]PathOwnership, 0, len(authorship)),
+		Directories: make([]PathOwnership, 0),
+	}
+
+	for file, fileAuthorship := range authorship {
+		ownership.Files = append(ownership.Files, newPathOwnership(file, fileAuthorship))
+
+		for author, lines := range fileAuthorship {
+			repository[author] += lines
+
+			for dir := path.Dir(file); dir != "."; dir = path.Dir(dir) {
+				if _, ok := directories[dir]; !ok {
+					directories[dir] = make(FileAuthorship)
+				}
+				directories[dir][author] += lines
+			}
+		}
+	}
+
+	for dir, dirAuthorship := range directories {
+		ownership.Directories = append(ownership.Directories, newPathOwnership(dir, dirAuthorship))
+	}
+	ownership.Repository = newPathOwnership("", repository)
+
+	sort.Slice(ownership.Files, func(i, j int) bool {
+		return ownership.Files[i].Path < ownership.Files[j].Path
+	})
+	sort.Slice(ownership.Directories, func(i, j int) bool {
+		return ownership.Directories[i].Path < ownership.Directories[j].Path
+	})
+
+	return ownership
+}
+
+// fileAuthorship returns the authorship of each file the ownership was aggregated from.
+func (o Ownership) fileAuthorship() map[string]FileAuthorship {
+	authorship := make(map[string]FileAuthorship, len(o.Files))
+	for _, file := range o.Files {
+		fileAuthorship := make(FileAuthorship, len(file.Owners))
+		for _, owner := range file.Owners {
+			fileAuthorship[owner.Email] = owner.Lines
+		}
+		authorship[file.Path] = fileAuthorship
+	}
+
+	return authorship
+}
+
+// newPathOwnership converts the line counts of each author of path into an ordered PathOwnership.
+func newPathOwnership(path string, authorship FileAuthorship) PathOwnership {
+	total := 0
+	for _, lines := range authorship {
+		total += lines
+	}
+
+	owners := make([]AuthorOwnership, 0, len(authorship))
+	for author, lines := range authorship {
+		owner := AuthorOwnership{Email: author, Lines: lines}
+		if total > 0 {
+			owner.Percent = float64(lines) * percent / float64(total)
+		}
+		owners = append(owners, owner)
+	}
+
+	sort.Slice(owners, func(i, j int) bool {
+		if owners[i].Lines != owners[j].Lines {
+			return owners[i].Lines > owners[j].Lines
+		}
+		return owners[i].Email < owners[j].Email
+	})
+
+	return PathOwnership{Path: path, Lines: total, Owners: owners}
+}
+
+// GetOwnership returns the ownership of the surviving lines at HEAD for the repository and each file and directory.
+func (r *RepoReader) GetOwnership() (Ownership, error) {
+	history, err := r.getHistory()
+	if err != nil {
+		return Ownership{}, fmt.Errorf("GetOwnership: unable to get the repository history: %w", err)
+	}
+	defer history.close()
+
+	authorship, err := r.getFileAuthorship(history)
+	if err != nil {
+		return Ownership{}, fmt.Errorf("GetOwnership: unable to get the file authorship: %w", err)
+	}
+
+	return r.getOwnership(authorship), nil
+}
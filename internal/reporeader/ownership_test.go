@@ -0,0 +1,170 @@
+package reporeader_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/djyuhn/gitcha/gittest"
+	"github.com/djyuhn/gitcha/internal/reporeader"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoReader_GetOwnership(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+	authorOne := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: start}
+	authorTwo := object.Signature{Name: "Gitcha Two", Email: "gitcha2@gitcha.com", When: start.Add(time.Hour)}
+	authorThree := object.Signature{Name: "Gitcha Three", Email: "gitcha3@gitcha.com", When: start.Add(2 * time.Hour)}
+	authorFour := object.Signature{Name: "Gitcha Four", Email: "gitcha4@gitcha.com", When: start.Add(3 * time.Hour)}
+
+	t.Run("given lines changed by different authors should return ownership per file, directory and repository", func(t *testing.T) {
+		t.Parallel()
+
+		commits := []gittest.LocalCommit{
+			{
+				Author:  authorOne,
+				Message: "Add service",
+				Files: map[string]string{
+					"svc/main.go": "one\ntwo\nthree\n",
+					"README.md":   "readme\n",
+				},
+			},
+			{
+				Author:  authorTwo,
+				Message: "Change service",
+				Files:   map[string]string{"svc/main.go": "one\nTWO\nthree\n"},
+			},
+		}
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		svcOwnership := reporeader.PathOwnership{
+			Lines: 3,
+			Owners: []reporeader.AuthorOwnership{
+				{Email: authorOne.Email, Lines: 2, Percent: 200.0 / 3},
+				{Email: authorTwo.Email, Lines: 1, Percent: 100.0 / 3},
+			},
+		}
+		mainOwnership := svcOwnership
+		mainOwnership.Path = "svc/main.go"
+		dirOwnership := svcOwnership
+		dirOwnership.Path = "svc"
+
+		expected := reporeader.Ownership{
+			Repository: reporeader.PathOwnership{
+				Path:  "",
+				Lines: 4,
+				Owners: []reporeader.AuthorOwnership{
+					{Email: authorOne.Email, Lines: 3, Percent: 75},
+					{Email: authorTwo.Email, Lines: 1, Percent: 25},
+				},
+			},
+			Files: []reporeader.PathOwnership{
+				{Path: "README.md", Lines: 1, Owners: []reporeader.AuthorOwnership{{Email: authorOne.Email, Lines: 1, Percent: 100}}},
+				mainOwnership,
+			},
+			Directories: []reporeader.PathOwnership{dirOwnership},
+		}
+
+		actual, err := repoReader.GetOwnership()
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("given merge commit should credit lines to the branch authors rather than the merge author", func(t *testing.T) {
+		t.Parallel()
+
+		commits := []gittest.LocalCommit{
+			{
+				Author:  authorOne,
+				Message: "Add file",
+				Files:   map[string]string{"file.txt": "one\ntwo\nthree\n"},
+			},
+			{
+				Author:  authorTwo,
+				Message: "Append line",
+				Files:   map[string]string{"file.txt": "one\ntwo\nthree\nfour\n"},
+				Parents: []int{0},
+			},
+			{
+				Author:  authorThree,
+				Message: "Prepend line",
+				Files:   map[string]string{"file.txt": "zero\none\ntwo\nthree\n"},
+				Parents: []int{0},
+			},
+			{
+				Author:  authorFour,
+				Message: "Merge branch",
+				Files:   map[string]string{"file.txt": "zero\none\ntwo\nthree\nfour\n"},
+				Parents: []int{2, 1},
+			},
+		}
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		expected := []reporeader.AuthorOwnership{
+			{Email: authorOne.Email, Lines: 3, Percent: 60},
+			{Email: authorTwo.Email, Lines: 1, Percent: 20},
+			{Email: authorThree.Email, Lines: 1, Percent: 20},
+		}
+
+		actual, err := repoReader.GetOwnership()
+
+		assert.NoError(t, err)
+		require.Len(t, actual.Files, 1)
+		assert.Equal(t, expected, actual.Files[0].Owners)
+	})
+	t.Run("given renamed and copied files should keep the line authors of the file they originate from", func(t *testing.T) {
+		t.Parallel()
+
+		commits := []gittest.LocalCommit{
+			{
+				Author:  authorOne,
+				Message: "Add files",
+				Files: map[string]string{
+					"old.txt":    "one\ntwo\nthree\nfour\n",
+					"source.txt": "five\nsix\nseven\neight\n",
+				},
+			},
+			{
+				Author:  authorTwo,
+				Message: "Rename old, copy source and change both",
+				Files: map[string]string{
+					"new.txt":    "one\ntwo\nthree\nfour\nfive\n",
+					"source.txt": "five\nsix\nseven\neight\nnine\n",
+					"copy.txt":   "five\nsix\nseven\neight\n",
+				},
+				Removed: []string{"old.txt"},
+			},
+		}
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetOwnership()
+		require.NoError(t, err)
+
+		require.Len(t, actual.Files, 3)
+		assert.Equal(t, "copy.txt", actual.Files[0].Path)
+		assert.Equal(t, []reporeader.AuthorOwnership{{Email: authorOne.Email, Lines: 4, Percent: 100}}, actual.Files[0].Owners)
+
+		assert.Equal(t, "new.txt", actual.Files[1].Path)
+		assert.Equal(t, []reporeader.AuthorOwnership{
+			{Email: authorOne.Email, Lines: 4, Percent: 80},
+			{Email: authorTwo.Email, Lines: 1, Percent: 20},
+		}, actual.Files[1].Owners)
+	})
+}
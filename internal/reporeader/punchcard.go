@@ -0,0 +1,57 @@
+package reporeader
+
+import (
+	"fmt"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+const (
+	daysInWeek = 7
+	hoursInDay = 24
+)
+
+// Punchcard holds commit counts by the weekday and hour they were authored, in the author's local time.
+//
+// The first index is the time.Weekday, starting at Sunday, and the second index is the hour of the day.
+type Punchcard [daysInWeek][hoursInDay]int
+
+// Max returns the highest commit count of any weekday and hour.
+func (p Punchcard) Max() int {
+	highest := 0
+	for _, hours := range p {
+		for _, count := range hours {
+			if count > highest {
+				highest = count
+			}
+		}
+	}
+
+	return highest
+}
+
+func (r *RepoReader) getPunchcard(commits []*object.Commit) Punchcard {
+	punchcard := Punchcard{}
+
+	for _, commit := range commits {
+		if r.excludesBot(commit.Author.Name, commit.Author.Email) {
+			continue
+		}
+
+		// The author signature keeps the timezone offset the commit was made in.
+		when := commit.Author.When
+		punchcard[when.Weekday()][when.Hour()]++
+	}
+
+	return punchcard
+}
+
+// GetPunchcard returns the commit counts of the repository by the weekday and hour in the author's local time.
+func (r *RepoReader) GetPunchcard() (Punchcard, error) {
+	commits, err := r.getCommits()
+	if err != nil {
+		return Punchcard{}, fmt.Errorf("GetPunchcard: unable to get the repository commits: %w", err)
+	}
+
+	return r.getPunchcard(commits), nil
+}
@@ -0,0 +1,67 @@
+package reporeader_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/djyuhn/gitcha/gittest"
+	"github.com/djyuhn/gitcha/internal/reporeader"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoReader_GetPunchcard(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given commits in different timezones should count commits by author local weekday and hour", func(t *testing.T) {
+		t.Parallel()
+
+		tokyo := time.FixedZone("", 9*60*60)
+		newYork := time.FixedZone("", -5*60*60)
+
+		// Both commits are at the same instant but in the authors' local time one is Monday 09:00 and the other is
+		// Sunday 19:00.
+		commits := []gittest.LocalCommit{
+			{
+				Author:  object.Signature{Name: "Tokyo", Email: "tokyo@gitcha.com", When: time.Date(2023, time.January, 2, 9, 0, 0, 0, tokyo)},
+				Message: "Tokyo commit",
+				Files:   map[string]string{"tokyo.txt": "tokyo\n"},
+			},
+			{
+				Author:  object.Signature{Name: "New York", Email: "newyork@gitcha.com", When: time.Date(2023, time.January, 1, 19, 0, 0, 0, newYork)},
+				Message: "New York commit",
+				Files:   map[string]string{"newyork.txt": "new york\n"},
+			},
+		}
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		expected := reporeader.Punchcard{}
+		expected[time.Monday][9] = 1
+		expected[time.Sunday][19] = 1
+
+		actual, err := repoReader.GetPunchcard()
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestPunchcard_Max(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given punchcard should return highest count", func(t *testing.T) {
+		t.Parallel()
+
+		punchcard := reporeader.Punchcard{}
+		punchcard[time.Tuesday][3] = 2
+		punchcard[time.Friday][17] = 5
+
+		assert.Equal(t, 5, punchcard.Max())
+	})
+}
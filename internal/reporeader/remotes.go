@@ -0,0 +1,108 @@
+package reporeader
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/djyuhn/gitcha/internal/hosting"
+)
+
+// originRemote is the name git gives the remote a repository is cloned from.
+const originRemote = "origin"
+
+// Remote is a configured remote along with the hosted project its first URL points to, if it points to one. The URLs
+// hold no credentials.
+type Remote struct {
+	Name    string           `json:"name"`
+	URLs    []string         `json:"urls"`
+	Project *hosting.Project `json:"project,omitempty"`
+}
+
+// Remotes holds the configured remotes of the repository ordered by name.
+type Remotes struct {
+	Remotes []Remote `json:"remotes"`
+}
+
+// Project returns the hosted project of the repository: the project of origin or, if origin is not hosted, of the
+// first hosted remote.
+func (r Remotes) Project() (hosting.Project, bool) {
+	var project *hosting.Project
+	for _, remote := range r.Remotes {
+		if remote.Project == nil {
+			continue
+		}
+		if remote.Name == originRemote {
+			return *remote.Project, true
+		}
+		if project == nil {
+			project = remote.Project
+		}
+	}
+
+	if project == nil {
+		return hosting.Project{}, false
+	}
+
+	return *project, true
+}
+
+// getRemotes reads the remotes from the repository configuration without contacting them.
+func (r *RepoReader) getRemotes() (Remotes, error) {
+	remotes, err := r.repository.Remotes()
+	if err != nil {
+		return Remotes{}, fmt.Errorf("getRemotes: unable to read the remotes: %w", err)
+	}
+
+	result := Remotes{Remotes: make([]Remote, 0, len(remotes))}
+	for _, remote := range remotes {
+		cfg := remote.Config()
+		entry := Remote{Name: cfg.Name, URLs: make([]string, 0, len(cfg.URLs))}
+		for _, remoteURL := range cfg.URLs {
+			entry.URLs = append(entry.URLs, stripCredentials(remoteURL))
+		}
+		if len(cfg.URLs) > 0 {
+			if project, err := hosting.Parse(cfg.URLs[0]); err == nil {
+				entry.Project = &project
+			}
+		}
+		result.Remotes = append(result.Remotes, entry)
+	}
+
+	sort.Slice(result.Remotes, func(i, j int) bool {
+		return result.Remotes[i].Name < result.Remotes[j].Name
+	})
+
+	return result, nil
+}
+
+// stripCredentials returns remoteURL without the credentials a URL such as https://token@github.com/owner/repo.git
+// carries in its user information. SSH URLs keep their user name, which names the account to log in as rather than
+// authenticating it, but lose any password. URLs in the scp-like syntax or that are paths are returned unchanged.
+func stripCredentials(remoteURL string) string {
+	parsed, err := url.Parse(remoteURL)
+	if err != nil || parsed.User == nil {
+		return remoteURL
+	}
+
+	if _, hasPassword := parsed.User.Password(); parsed.Scheme == "ssh" && !hasPassword {
+		return remoteURL
+	}
+	if parsed.Scheme == "ssh" {
+		parsed.User = url.User(parsed.User.Username())
+	} else {
+		parsed.User = nil
+	}
+
+	return parsed.String()
+}
+
+// GetRemotes returns the configured remotes of the repository and the hosted projects they point to.
+func (r *RepoReader) GetRemotes() (Remotes, error) {
+	remotes, err := r.getRemotes()
+	if err != nil {
+		return Remotes{}, fmt.Errorf("GetRemotes: %w", err)
+	}
+
+	return remotes, nil
+}
@@ -2,6 +2,7 @@ package reporeader
 
 import (
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/go-enry/go-license-detector/v4/licensedb"
@@ -14,55 +15,129 @@ import (
 
 type RepoReader struct {
 	repository *git.Repository
+
+	similarity        uint
+	detectCopies      bool
+	coAuthorTrailers  []string
+	creditMode        CreditMode
+	botMode           BotMode
+	botPatterns       []string
+	staleAfter        time.Duration
+	issuePatterns     []*regexp.Regexp
+	keyring           Keyring
+	protectedBranches []string
+	largeBlobCount    int
+	analyses          map[Analysis]bool
 }
 
+// RepoDetails holds every analysis of the repository.
+//
+// Errors holds the error of each supplementary analysis that failed, keyed by its name such as branches, tags or
+// authorship, whose fields are then left empty rather than failing the whole report. Reading the commit history the
+// rest builds on and the license still fail GetRepoDetails. Deferred lists the analyses that were not run, whose
+// fields are left empty until they are loaded with LoadAnalysis.
 type RepoDetails struct {
-	CreatedDate    time.Time
-	AuthorsCommits map[string][]Commit
-	License        string
+	CreatedDate    time.Time           `json:"createdDate"`
+	AuthorsCommits map[string][]Commit `json:"authorsCommits"`
+	License        string              `json:"license"`
+	Punchcard      Punchcard           `json:"punchcard"`
+	Timezones      Timezones           `json:"timezones"`
+	BusFactor      BusFactor           `json:"busFactor"`
+	Ownership      Ownership           `json:"ownership"`
+	FileHistories  []FileHistory       `json:"fileHistories"`
+	Tree           TreeNode            `json:"tree"`
+	BotCommits     map[string][]Commit `json:"botCommits,omitempty"`
+	Conventions    Conventions         `json:"conventions"`
+	Releases       Releases            `json:"releases"`
+	Versions       Versions            `json:"versions"`
+	Branches       Branches            `json:"branches"`
+	Status         Status              `json:"status"`
+	Remotes        Remotes             `json:"remotes"`
+	Merges         Merges              `json:"merges"`
+	Reverts        Reverts             `json:"reverts"`
+	Issues         IssueReferences     `json:"issues"`
+	Signatures     Signatures          `json:"signatures"`
+	Size           RepoSize            `json:"size"`
+	Errors         map[string]string   `json:"errors,omitempty"`
+	Deferred       []Analysis          `json:"deferred,omitempty"`
 }
 
 type Author struct {
-	Name  string
-	Email string
+	Name  string `json:"name"`
+	Email string `json:"email"`
 }
 
 type Commit struct {
-	Author  Author
-	Message string
-	Hash    string
+	Author    Author    `json:"author"`
+	CoAuthors []Author  `json:"coAuthors,omitempty"`
+	Message   string    `json:"message"`
+	Hash      string    `json:"hash"`
+	Date      time.Time `json:"date"`
+}
+
+// ShortHashLength is the number of leading hash characters used to refer to a commit, the git default.
+const ShortHashLength = 7
+
+// ShortHash abbreviates a commit hash to ShortHashLength characters the way git does.
+func ShortHash(hash string) string {
+	if len(hash) > ShortHashLength {
+		return hash[:ShortHashLength]
+	}
+
+	return hash
+}
+
+// Contributor returns the author or co-author of the commit with email.
+func (c Commit) Contributor(email string) (Author, bool) {
+	if c.Author.Email == email {
+		return c.Author, true
+	}
+	for _, coAuthor := range c.CoAuthors {
+		if coAuthor.Email == email {
+			return coAuthor, true
+		}
+	}
+
+	return Author{}, false
 }
 
-func NewRepoReader(dir string) (*RepoReader, error) {
+func NewRepoReader(dir string, opts ...Option) (*RepoReader, error) {
 	repo, err := git.PlainOpen(dir)
 	if err != nil {
 		return nil, fmt.Errorf("NewRepoReader: error detected in attempting to open repository: %w", err)
 	}
 
-	return &RepoReader{repository: repo}, nil
+	r := newRepoReader(opts)
+	r.repository = repo
+
+	return r, nil
 }
 
-func NewRepoReaderRepository(repo *git.Repository) (*RepoReader, error) {
+func NewRepoReaderRepository(repo *git.Repository, opts ...Option) (*RepoReader, error) {
 	_, err := ValidateRepository(repo)
 	if err != nil {
 		return nil, fmt.Errorf("NewRepoReaderRepository: received an invalid repository: %w", err)
 	}
 
-	return &RepoReader{repository: repo}, nil
+	r := newRepoReader(opts)
+	r.repository = repo
+
+	return r, nil
 }
 
+// GetRepoDetails analyzes the repository. A failing supplementary analysis is recorded in the Errors of the details
+// rather than returned, and the analyses not enabled with WithAnalyses are deferred to LoadAnalysis.
 func (r *RepoReader) GetRepoDetails() (RepoDetails, error) {
-	head, err := r.repository.Head()
+	history, err := r.getHistory()
 	if err != nil {
-		return RepoDetails{}, fmt.Errorf("GetRepoDetails: unable to get the repository head: %w", err)
+		return RepoDetails{}, fmt.Errorf("GetRepoDetails: unable to get the repository history: %w", err)
 	}
+	defer history.close()
 
-	commits := make([]*object.Commit, 0)
-	cIter, _ := r.repository.Log(&git.LogOptions{From: head.Hash(), Order: git.LogOrderCommitterTime})
-	_ = cIter.ForEach(func(c *object.Commit) error {
-		commits = append(commits, c)
-		return nil
-	})
+	commits, err := history.allCommits()
+	if err != nil {
+		return RepoDetails{}, fmt.Errorf("GetRepoDetails: unable to get the repository commits: %w", err)
+	}
 
 	wt, err := r.repository.Worktree()
 	if err != nil {
@@ -71,6 +146,92 @@ func (r *RepoReader) GetRepoDetails() (RepoDetails, error) {
 
 	createdDate := r.getCreatedDate(commits)
 	authorsCommits := r.getAuthorsByCommits(commits)
+	punchcard := r.getPunchcard(commits)
+	timezones := r.getTimezones(commits)
+
+	errs := make(map[string]string)
+
+	var deferred []Analysis
+	var authorship map[string]FileAuthorship
+	var busFactor BusFactor
+	var ownership Ownership
+	if r.analyses[AnalysisAuthorship] {
+		authorship, err = r.getFileAuthorship(history)
+		if err != nil {
+			errs[string(AnalysisAuthorship)] = err.Error()
+		} else {
+			busFactor = r.getBusFactor(authorship)
+			ownership = r.getOwnership(authorship)
+		}
+	} else {
+		deferred = append(deferred, AnalysisAuthorship)
+	}
+
+	var fileHistories []FileHistory
+	if r.analyses[AnalysisHistories] {
+		fileHistories, err = r.getFileHistories(history)
+		if err != nil {
+			errs[string(AnalysisHistories)] = err.Error()
+		}
+	} else {
+		deferred = append(deferred, AnalysisHistories)
+	}
+
+	tree, err := r.getTree(history, authorship, fileHistories)
+	if err != nil {
+		errs["tree"] = err.Error()
+	}
+
+	var releases Releases
+	tags, err := r.getTags()
+	if err != nil {
+		errs["tags"] = err.Error()
+	} else {
+		releases, err = r.getReleases(tags)
+		if err != nil {
+			errs["releases"] = err.Error()
+		}
+	}
+
+	merges, err := r.getMerges(commits)
+	if err != nil {
+		errs["merges"] = err.Error()
+	}
+
+	reverts, err := r.getReverts(commits)
+	if err != nil {
+		errs["reverts"] = err.Error()
+	}
+
+	signatures, err := r.getSignatures(commits)
+	if err != nil {
+		errs["signatures"] = err.Error()
+	}
+
+	var size RepoSize
+	if r.analyses[AnalysisSize] {
+		size, err = r.getRepoSize(history)
+		if err != nil {
+			errs["size"] = err.Error()
+		}
+	} else {
+		deferred = append(deferred, AnalysisSize)
+	}
+
+	branches, err := r.getBranches()
+	if err != nil {
+		errs["branches"] = err.Error()
+	}
+
+	status, err := r.getStatus(wt)
+	if err != nil {
+		errs["status"] = err.Error()
+	}
+
+	remotes, err := r.getRemotes()
+	if err != nil {
+		errs["remotes"] = err.Error()
+	}
 
 	license, err := r.getLicenseFromRoot(wt.Filesystem)
 	if err != nil {
@@ -81,11 +242,93 @@ func (r *RepoReader) GetRepoDetails() (RepoDetails, error) {
 		CreatedDate:    createdDate,
 		AuthorsCommits: authorsCommits,
 		License:        license,
+		Punchcard:      punchcard,
+		Timezones:      timezones,
+		BusFactor:      busFactor,
+		Ownership:      ownership,
+		FileHistories:  fileHistories,
+		Tree:           tree,
+		BotCommits:     r.getBotCommits(commits),
+		Conventions:    r.getConventions(commits),
+		Releases:       releases,
+		Versions:       r.getVersions(tags),
+		Branches:       branches,
+		Status:         status,
+		Remotes:        remotes,
+		Merges:         merges,
+		Reverts:        reverts,
+		Issues:         r.getIssueReferences(r.getAuditedCommits(commits)),
+		Signatures:     signatures,
+		Size:           size,
+		Deferred:       deferred,
+	}
+	if len(errs) > 0 {
+		details.Errors = errs
+	}
+
+	return details, nil
+}
+
+// LoadAnalysis runs analysis, deferred by the GetRepoDetails that returned details, and returns details with its
+// results and the tree annotated with them. The analysis is no longer listed as deferred.
+func (r *RepoReader) LoadAnalysis(details RepoDetails, analysis Analysis) (RepoDetails, error) {
+	history, err := r.getHistory()
+	if err != nil {
+		return RepoDetails{}, fmt.Errorf("LoadAnalysis: unable to get the repository history: %w", err)
+	}
+	defer history.close()
+
+	authorship := details.Ownership.fileAuthorship()
+
+	switch analysis {
+	case AnalysisAuthorship:
+		authorship, err = r.getFileAuthorship(history)
+		if err != nil {
+			return RepoDetails{}, fmt.Errorf("LoadAnalysis: unable to get the file authorship: %w", err)
+		}
+		details.BusFactor = r.getBusFactor(authorship)
+		details.Ownership = r.getOwnership(authorship)
+	case AnalysisHistories:
+		details.FileHistories, err = r.getFileHistories(history)
+		if err != nil {
+			return RepoDetails{}, fmt.Errorf("LoadAnalysis: unable to get the file histories: %w", err)
+		}
+	case AnalysisSize:
+		details.Size, err = r.getRepoSize(history)
+		if err != nil {
+			return RepoDetails{}, fmt.Errorf("LoadAnalysis: unable to get the repository size: %w", err)
+		}
+	default:
+		return RepoDetails{}, fmt.Errorf("LoadAnalysis: unsupported analysis %q", analysis)
+	}
+
+	details.Tree, err = r.getTree(history, authorship, details.FileHistories)
+	if err != nil {
+		return RepoDetails{}, fmt.Errorf("LoadAnalysis: unable to get the tree: %w", err)
 	}
 
+	var deferred []Analysis
+	for _, pending := range details.Deferred {
+		if pending != analysis {
+			deferred = append(deferred, pending)
+		}
+	}
+	details.Deferred = deferred
+
 	return details, nil
 }
 
+// IsDeferred returns whether analysis was deferred and its results are not part of the details yet.
+func (d RepoDetails) IsDeferred(analysis Analysis) bool {
+	for _, deferred := range d.Deferred {
+		if deferred == analysis {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (r *RepoReader) getCreatedDate(commits []*object.Commit) time.Time {
 	if len(commits) == 0 {
 		return time.Time{}
@@ -103,27 +346,59 @@ func (r *RepoReader) getCreatedDate(commits []*object.Commit) time.Time {
 	return oldestTime
 }
 
+// getAuthorsByCommits groups commits by the email of their author and, with shared credit, of their co-authors. Bots
+// are left out unless they are included in the analysis.
 func (r *RepoReader) getAuthorsByCommits(commits []*object.Commit) map[string][]Commit {
 	contributorCommits := make(map[string][]Commit)
 
 	for _, commit := range commits {
-		author := Author{
-			commit.Author.Name,
-			commit.Author.Email,
-		}
+		commit := r.newCommit(commit)
 
-		commit := Commit{
-			Author:  author,
-			Message: commit.Message,
-			Hash:    commit.Hash.String(),
+		for _, email := range r.getCreditedEmails(commit) {
+			contributorCommits[email] = append(contributorCommits[email], commit)
 		}
-
-		contributorCommits[author.Email] = append(contributorCommits[author.Email], commit)
 	}
 
 	return contributorCommits
 }
 
+// newCommit converts commit into a Commit along with the co-authors listed in its trailers.
+func (r *RepoReader) newCommit(commit *object.Commit) Commit {
+	author := Author{
+		commit.Author.Name,
+		commit.Author.Email,
+	}
+
+	return Commit{
+		Author:    author,
+		CoAuthors: parseCoAuthors(commit.Message, author, r.coAuthorTrailers),
+		Message:   commit.Message,
+		Hash:      commit.Hash.String(),
+		Date:      commit.Author.When,
+	}
+}
+
+// getCreditedEmails returns the emails of the authors credited with commit: its author and, with shared credit, its
+// co-authors. Commits authored by bots left out of the analysis are credited to no one.
+func (r *RepoReader) getCreditedEmails(commit Commit) []string {
+	if r.excludesBot(commit.Author.Name, commit.Author.Email) {
+		return nil
+	}
+
+	emails := []string{commit.Author.Email}
+	if r.creditMode != CreditShared {
+		return emails
+	}
+
+	for _, coAuthor := range commit.CoAuthors {
+		if !r.excludesBot(coAuthor.Name, coAuthor.Email) {
+			emails = append(emails, coAuthor.Email)
+		}
+	}
+
+	return emails
+}
+
 func (r *RepoReader) getLicenseFromRoot(fs billy.Filesystem) (string, error) {
 	path, err := filer.FromDirectory(fs.Root())
 	if err != nil {
@@ -156,36 +431,22 @@ func (r *RepoReader) getLicenseFromRoot(fs billy.Filesystem) (string, error) {
 
 // GetCreatedDate returns the time that the repository was first created.
 func (r *RepoReader) GetCreatedDate() (time.Time, error) {
-	head, err := r.repository.Head()
+	commits, err := r.getCommits()
 	if err != nil {
-		return time.Time{}, fmt.Errorf("GetCreatedDate: unable to get the repository head: %w", err)
+		return time.Time{}, fmt.Errorf("GetCreatedDate: unable to get the repository commits: %w", err)
 	}
 
-	commits := make([]*object.Commit, 0)
-	cIter, _ := r.repository.Log(&git.LogOptions{From: head.Hash(), Order: git.LogOrderCommitterTime})
-	_ = cIter.ForEach(func(c *object.Commit) error {
-		commits = append(commits, c)
-		return nil
-	})
-
 	return r.getCreatedDate(commits), nil
 }
 
 // GetAuthorsByCommits returns the authors with their email as the key and their commits they made.
 func (r *RepoReader) GetAuthorsByCommits() (map[string][]Commit, error) {
-	head, err := r.repository.Head()
+	commits, err := r.getCommits()
 	if err != nil {
 		defaultContributorCommits := make(map[string][]Commit)
-		return defaultContributorCommits, fmt.Errorf("GetAuthorsByCommits: unable to get the repository head: %w", err)
+		return defaultContributorCommits, fmt.Errorf("GetAuthorsByCommits: unable to get the repository commits: %w", err)
 	}
 
-	commits := make([]*object.Commit, 0)
-	cIter, _ := r.repository.Log(&git.LogOptions{From: head.Hash(), Order: git.LogOrderCommitterTime})
-	_ = cIter.ForEach(func(c *object.Commit) error {
-		commits = append(commits, c)
-		return nil
-	})
-
 	authorCommits := r.getAuthorsByCommits(commits)
 
 	return authorCommits, nil
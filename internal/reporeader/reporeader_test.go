@@ -3,12 +3,16 @@ package reporeader_test
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/djyuhn/gitcha/gittest"
 	"github.com/djyuhn/gitcha/internal/reporeader"
 
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -131,6 +135,7 @@ func TestRepoReader_GetRepoDetails(t *testing.T) {
 				Author:  author,
 				Message: commit.Message,
 				Hash:    commit.Hash.String(),
+				Date:    commit.Author.When,
 			}
 
 			expectedCommits = append(expectedCommits, commit)
@@ -221,6 +226,80 @@ func TestRepoReader_GetRepoDetails(t *testing.T) {
 		assert.Equal(t, "NO LICENSE", actual.License)
 		assert.NoError(t, err)
 	})
+
+	t.Run("given remote-tracking branch with missing commit should record the branches error and return other details", func(t *testing.T) {
+		t.Parallel()
+
+		author := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)}
+		_, repo, err := gittest.CreateLocalRepo(t, []gittest.LocalCommit{
+			{Author: author, Message: "Initial commit", Files: map[string]string{"README.md": "# gitcha\n"}},
+		})
+		require.NoError(t, err)
+
+		missing := plumbing.NewHash("0123456789abcdef0123456789abcdef01234567")
+		require.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewRemoteReferenceName("origin", "gone"), missing)))
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo, reporeader.WithAnalyses(reporeader.AnalysisSize))
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetRepoDetails()
+		require.NoError(t, err)
+
+		require.Contains(t, actual.Errors, "branches")
+		assert.Contains(t, actual.Errors["branches"], "unable to find commit "+missing.String())
+		assert.Len(t, actual.Errors, 1)
+		assert.Empty(t, actual.Branches.Branches)
+		assert.Contains(t, actual.AuthorsCommits, author.Email)
+		assert.Equal(t, 1, actual.Size.Objects["commit"].Count)
+	})
+
+	t.Run("given tree of HEAD missing should record the errors of the analyses reading it and return other details", func(t *testing.T) {
+		t.Parallel()
+
+		author := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)}
+		dir, repo, err := gittest.CreateLocalRepo(t, []gittest.LocalCommit{
+			{Author: author, Message: "Initial commit", Files: map[string]string{"README.md": "# gitcha\n"}},
+		})
+		require.NoError(t, err)
+
+		head, err := repo.Head()
+		require.NoError(t, err)
+		commit, err := repo.CommitObject(head.Hash())
+		require.NoError(t, err)
+		treeHash := commit.TreeHash.String()
+		require.NoError(t, os.Remove(filepath.Join(dir, ".git", "objects", treeHash[:2], treeHash[2:])))
+
+		repoReader, err := reporeader.NewRepoReader(dir, reporeader.WithAnalyses(reporeader.Analyses...))
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetRepoDetails()
+		require.NoError(t, err)
+
+		for _, analysis := range []string{"authorship", "histories", "tree", "size", "status"} {
+			assert.Contains(t, actual.Errors, analysis)
+		}
+		assert.Contains(t, actual.AuthorsCommits, author.Email)
+		assert.NotEmpty(t, actual.Branches.Branches)
+	})
+
+	t.Run("given repository whose analyses succeed should not record errors", func(t *testing.T) {
+		t.Parallel()
+
+		author := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)}
+		_, repo, err := gittest.CreateLocalRepo(t, []gittest.LocalCommit{
+			{Author: author, Message: "Initial commit", Files: map[string]string{"README.md": "# gitcha\n"}},
+		})
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetRepoDetails()
+		require.NoError(t, err)
+
+		assert.Nil(t, actual.Errors)
+		assert.NotEmpty(t, actual.Branches.Branches)
+	})
 }
 
 func TestRepoReader_GetCreatedDate(t *testing.T) {
@@ -282,6 +361,7 @@ func TestRepoReader_GetAuthorsByCommits(t *testing.T) {
 				Author:  author,
 				Message: commit.Message,
 				Hash:    commit.Hash.String(),
+				Date:    commit.Author.When,
 			}
 
 			expectedCommits = append(expectedCommits, commit)
@@ -487,3 +567,23 @@ func TestValidateRepository(t *testing.T) {
 		assert.Equal(t, expected, head)
 	})
 }
+
+func TestShortHash(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given full hash should return its first seven characters", func(t *testing.T) {
+		t.Parallel()
+
+		actual := reporeader.ShortHash("0123456789abcdef0123456789abcdef01234567")
+
+		assert.Equal(t, "0123456", actual)
+	})
+
+	t.Run("given hash shorter than the short length should return it unchanged", func(t *testing.T) {
+		t.Parallel()
+
+		actual := reporeader.ShortHash("0123")
+
+		assert.Equal(t, "0123", actual)
+	})
+}
@@ -0,0 +1,208 @@
+package reporeader
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+var (
+	// revertPattern matches the line git revert adds to the message of a revert commit, such as
+	// "This reverts commit 0123456789abcdef0123456789abcdef01234567.".
+	revertPattern = regexp.MustCompile(`This reverts commit ([0-9a-fA-F]{7,40})`)
+	// revertSubjectPattern matches the subject git revert gives a revert commit, such as `Revert "Add the tree view"`.
+	revertSubjectPattern = regexp.MustCompile(`^Revert "`)
+	// fixPattern matches a subject that starts by describing a fix, such as "Fix crash", "Bugfix: empty config" or
+	// "Hotfix for the login page". Fix words elsewhere in the subject, as in "Add regression tests" or "Merge the hotfix
+	// branch", do not make a fix.
+	fixPattern = regexp.MustCompile(`(?i)^(bug ?fix(e[sd]|ing)?|hot ?fix(e[sd]|ing)?|fix(e[sd]|ing)?)\b`)
+)
+
+// Revert is a commit reverting an earlier commit.
+//
+// Reverted is the hash of the reverted commit, or an empty string when the commit it names is not in the history.
+// Files are the files changed by the reverted commit or, when it is not in the history, by the revert itself.
+type Revert struct {
+	Hash           string    `json:"hash"`
+	Author         Author    `json:"author"`
+	Date           time.Time `json:"date"`
+	Reverted       string    `json:"reverted,omitempty"`
+	RevertedAuthor Author    `json:"revertedAuthor"`
+	Files          []string  `json:"files"`
+}
+
+// Reverts summarizes the revert and fix commits of the repository as a rough quality signal.
+//
+// Commits counts the commits, other than merges, the shares are relative to. Files counts how often each file was
+// changed by a reverted commit and Authors how often each author had a commit reverted, by email.
+type Reverts struct {
+	Commits int            `json:"commits"`
+	Fixes   int            `json:"fixes"`
+	Reverts []Revert       `json:"reverts"`
+	Files   map[string]int `json:"files"`
+	Authors map[string]int `json:"authors"`
+}
+
+// RevertShare returns the percentage of commits that are reverts.
+func (r Reverts) RevertShare() float64 {
+	if r.Commits == 0 {
+		return 0
+	}
+
+	return float64(len(r.Reverts)) * percent / float64(r.Commits)
+}
+
+// FixShare returns the percentage of commits that are fixes.
+func (r Reverts) FixShare() float64 {
+	if r.Commits == 0 {
+		return 0
+	}
+
+	return float64(r.Fixes) * percent / float64(r.Commits)
+}
+
+// SortedFiles returns the reverted files from the most to the least often reverted, then by path.
+func (r Reverts) SortedFiles() []string {
+	return sortedByCount(r.Files)
+}
+
+// SortedAuthors returns the emails of the authors of reverted commits from the most to the least often reverted, then
+// by email.
+func (r Reverts) SortedAuthors() []string {
+	return sortedByCount(r.Authors)
+}
+
+// parseReverted returns the abbreviated or full hash of the commit reverted by a commit with message, and whether the
+// commit is a revert. A revert whose message does not name the reverted commit returns an empty hash.
+func parseReverted(message string) (string, bool) {
+	if match := revertPattern.FindStringSubmatch(message); match != nil {
+		return strings.ToLower(match[1]), true
+	}
+
+	return "", revertSubjectPattern.MatchString(message)
+}
+
+// isFix returns whether a commit with message fixes something: its Conventional Commits type is fix or its subject
+// starts by describing a fix.
+func isFix(message string) bool {
+	if conventional, ok := ParseConventionalCommit(message); ok && conventional.Type == "fix" {
+		return true
+	}
+
+	// The subject is checked for types such as bugfix and hotfix as well.
+	subject, _, _ := strings.Cut(message, "\n")
+
+	return fixPattern.MatchString(subject)
+}
+
+// getReverts finds the revert and fix commits among commits, other than merges and the commits of bots left out of
+// the analysis, and links every revert to the commit it reverts.
+func (r *RepoReader) getReverts(commits []*object.Commit) (Reverts, error) {
+	reverts := Reverts{Reverts: make([]Revert, 0), Files: make(map[string]int), Authors: make(map[string]int)}
+
+	for _, commit := range commits {
+		if commit.NumParents() > 1 || r.excludesBot(commit.Author.Name, commit.Author.Email) {
+			continue
+		}
+		reverts.Commits++
+
+		reverted, ok := parseReverted(commit.Message)
+		if !ok {
+			if isFix(commit.Message) {
+				reverts.Fixes++
+			}
+			continue
+		}
+
+		revert := Revert{
+			Hash:   commit.Hash.String(),
+			Author: Author{Name: commit.Author.Name, Email: commit.Author.Email},
+			Date:   commit.Author.When,
+		}
+
+		changed := commit
+		if revertedCommit := findCommit(commits, reverted); revertedCommit != nil {
+			changed = revertedCommit
+			revert.Reverted = revertedCommit.Hash.String()
+			revert.RevertedAuthor = Author{Name: revertedCommit.Author.Name, Email: revertedCommit.Author.Email}
+			reverts.Authors[revertedCommit.Author.Email]++
+		}
+
+		files, err := r.getChangedFiles(changed)
+		if err != nil {
+			return Reverts{}, fmt.Errorf("getReverts: %w", err)
+		}
+		revert.Files = files
+		for _, file := range files {
+			reverts.Files[file]++
+		}
+
+		reverts.Reverts = append(reverts.Reverts, revert)
+	}
+
+	// Commits are walked from the newest, list the reverts from the oldest like the other timelines.
+	sort.SliceStable(reverts.Reverts, func(i, j int) bool {
+		return reverts.Reverts[i].Date.Before(reverts.Reverts[j].Date)
+	})
+
+	return reverts, nil
+}
+
+// findCommit returns the commit whose hash starts with the abbreviated hash, or nil when there is none.
+func findCommit(commits []*object.Commit, hash string) *object.Commit {
+	if hash == "" {
+		return nil
+	}
+
+	for _, commit := range commits {
+		if strings.HasPrefix(commit.Hash.String(), hash) {
+			return commit
+		}
+	}
+
+	return nil
+}
+
+// getChangedFiles returns the paths of the files changed by commit relative to its first parent, ordered by path.
+func (r *RepoReader) getChangedFiles(commit *object.Commit) ([]string, error) {
+	node, err := r.commitNode(commit)
+	if err != nil {
+		return nil, fmt.Errorf("getChangedFiles: %w", err)
+	}
+
+	changes, err := r.getFirstParentChanges(node)
+	if err != nil {
+		return nil, fmt.Errorf("getChangedFiles: %w", err)
+	}
+
+	files := make([]string, 0, len(changes))
+	for _, change := range changes {
+		path := change.To.Name
+		if path == "" {
+			path = change.From.Name
+		}
+		files = append(files, path)
+	}
+	sort.Strings(files)
+
+	return files, nil
+}
+
+// GetReverts returns the revert and fix commits of the repository.
+func (r *RepoReader) GetReverts() (Reverts, error) {
+	commits, err := r.getCommits()
+	if err != nil {
+		return Reverts{}, fmt.Errorf("GetReverts: %w", err)
+	}
+
+	reverts, err := r.getReverts(commits)
+	if err != nil {
+		return Reverts{}, fmt.Errorf("GetReverts: %w", err)
+	}
+
+	return reverts, nil
+}
@@ -0,0 +1,129 @@
+package reporeader_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/djyuhn/gitcha/gittest"
+	"github.com/djyuhn/gitcha/internal/reporeader"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoReader_GetReverts(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+	authorOne := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: start}
+	authorTwo := object.Signature{Name: "Gitcha Two", Email: "gitcha2@gitcha.com", When: start.Add(time.Hour)}
+
+	t.Run("given revert and fix commits should link reverts and count fixes", func(t *testing.T) {
+		t.Parallel()
+
+		base := []gittest.LocalCommit{
+			{Author: authorOne, Message: "Initial commit", Files: map[string]string{"README.md": "# gitcha\n"}},
+			{Author: authorTwo, Message: "Add the cache", Files: map[string]string{"cache.go": "package cache\n", "README.md": "# gitcha\ncache\n"}},
+			{Author: authorOne, Message: "fix(cache): evict stale entries", Files: map[string]string{"cache.go": "package cache\n// evict\n"}},
+			{Author: authorOne, Message: "Hotfix for the login page", Files: map[string]string{"login.go": "package login\n"}},
+			{Author: authorTwo, Message: "feat: add a prefix fixture", Files: map[string]string{"prefix.go": "package prefix\n"}},
+		}
+		// Commits with the same content, authors and dates have the same hashes, so a repository with the base commits
+		// gives the hash the revert message names.
+		_, repo, err := gittest.CreateLocalRepo(t, base)
+		require.NoError(t, err)
+		hashes := commitHashesByMessage(t, repo)
+
+		commits := append(base,
+			gittest.LocalCommit{
+				Author:  authorOne,
+				Message: fmt.Sprintf("Revert \"Add the cache\"\n\nThis reverts commit %s.\n", hashes["Add the cache"]),
+				Removed: []string{"cache.go"},
+			},
+			gittest.LocalCommit{
+				Author:  authorTwo,
+				Message: "Revert \"Hotfix for the login page\"\n\nThis reverts commit deadbeef.\n",
+				Removed: []string{"login.go"},
+			},
+		)
+		_, repo, err = gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+		hashes = commitHashesByMessage(t, repo)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetReverts()
+		require.NoError(t, err)
+
+		assert.Equal(t, 7, actual.Commits)
+		assert.Equal(t, 2, actual.Fixes)
+		require.Len(t, actual.Reverts, 2)
+
+		linked := actual.Reverts[0]
+		assert.Equal(t, hashes["Add the cache"].String(), linked.Reverted)
+		assert.Equal(t, reporeader.Author{Name: authorTwo.Name, Email: authorTwo.Email}, linked.RevertedAuthor)
+		assert.Equal(t, reporeader.Author{Name: authorOne.Name, Email: authorOne.Email}, linked.Author)
+		assert.Equal(t, []string{"README.md", "cache.go"}, linked.Files)
+
+		unlinked := actual.Reverts[1]
+		assert.Empty(t, unlinked.Reverted)
+		assert.Equal(t, []string{"login.go"}, unlinked.Files)
+
+		assert.Equal(t, map[string]int{"README.md": 1, "cache.go": 1, "login.go": 1}, actual.Files)
+		assert.Equal(t, map[string]int{authorTwo.Email: 1}, actual.Authors)
+		assert.InDelta(t, 28.57, actual.RevertShare(), 0.01)
+		assert.InDelta(t, 28.57, actual.FixShare(), 0.01)
+	})
+
+	t.Run("given subjects mentioning fixes should only count those starting with a fix", func(t *testing.T) {
+		t.Parallel()
+
+		messages := []string{
+			// Fixes.
+			"Fix the crash on startup",
+			"Fixed the login redirect",
+			"Bugfix: handle an empty config",
+			"hotfix: restore the cache",
+			"fix(cache)!: drop the stale entries",
+			// Not fixes.
+			"Add regression tests for the parser",
+			"Update the bug report template",
+			"Patch the version in the changelog",
+			"Merge the hotfix branch",
+			"Document how to fix a broken install",
+			"Add the prefix fixtures",
+			"feat: fix the order of the tabs",
+		}
+		commits := make([]gittest.LocalCommit, 0, len(messages))
+		for i, message := range messages {
+			commits = append(commits, gittest.LocalCommit{
+				Author:  authorOne,
+				Message: message,
+				Files:   map[string]string{fmt.Sprintf("file%d.txt", i): message + "\n"},
+			})
+		}
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetReverts()
+		require.NoError(t, err)
+
+		assert.Equal(t, len(messages), actual.Commits)
+		assert.Equal(t, 5, actual.Fixes)
+	})
+
+	t.Run("given no commits should return zero shares", func(t *testing.T) {
+		t.Parallel()
+
+		actual := reporeader.Reverts{}
+
+		assert.Zero(t, actual.RevertShare())
+		assert.Zero(t, actual.FixShare())
+	})
+}
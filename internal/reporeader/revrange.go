@@ -0,0 +1,61 @@
+package reporeader
+
+import (
+	"fmt"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object/commitgraph"
+)
+
+// GetCommitRange returns the non-merge commits reachable from the revision to but not from the revision from, newest
+// first, like git log --no-merges from..to. An empty from returns every non-merge commit reachable from to and an
+// empty to is HEAD. Commits authored by bots left out of the analysis are skipped.
+func (r *RepoReader) GetCommitRange(from, to string) ([]Commit, error) {
+	if to == "" {
+		to = plumbing.HEAD.String()
+	}
+
+	toHash, err := r.repository.ResolveRevision(plumbing.Revision(to))
+	if err != nil {
+		return nil, fmt.Errorf("GetCommitRange: unable to resolve revision %s: %w", to, err)
+	}
+
+	excluded := make(map[plumbing.Hash]bool)
+	if from != "" {
+		fromHash, err := r.repository.ResolveRevision(plumbing.Revision(from))
+		if err != nil {
+			return nil, fmt.Errorf("GetCommitRange: unable to resolve revision %s: %w", from, err)
+		}
+
+		err = r.walkCommitNodes(*fromHash, func(node commitgraph.CommitNode) error {
+			excluded[node.ID()] = true
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("GetCommitRange: %w", err)
+		}
+	}
+
+	commits := make([]Commit, 0)
+	err = r.walkCommitNodes(*toHash, func(node commitgraph.CommitNode) error {
+		if excluded[node.ID()] || node.NumParents() > 1 {
+			return nil
+		}
+
+		commit, err := node.Commit()
+		if err != nil {
+			return fmt.Errorf("unable to read commit %s: %w", node.ID(), err)
+		}
+		if r.excludesBot(commit.Author.Name, commit.Author.Email) {
+			return nil
+		}
+
+		commits = append(commits, r.newCommit(commit))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("GetCommitRange: %w", err)
+	}
+
+	return commits, nil
+}
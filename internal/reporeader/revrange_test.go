@@ -0,0 +1,95 @@
+package reporeader_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/djyuhn/gitcha/gittest"
+	"github.com/djyuhn/gitcha/internal/reporeader"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoReader_GetCommitRange(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+	signature := func(hours int) object.Signature {
+		return object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: start.Add(time.Duration(hours) * time.Hour)}
+	}
+
+	commits := []gittest.LocalCommit{
+		{Author: signature(0), Message: "feat: first", Files: map[string]string{"a.txt": "a\n"}},
+		{Author: signature(1), Message: "feat: second", Files: map[string]string{"b.txt": "b\n"}},
+		{Author: signature(2), Message: "fix: branch", Files: map[string]string{"c.txt": "c\n"}, Parents: []int{1}},
+		{Author: signature(3), Message: "feat: main", Files: map[string]string{"d.txt": "d\n"}, Parents: []int{1}},
+		{Author: signature(4), Message: "Merge branch", Files: map[string]string{"c.txt": "c\n"}, Parents: []int{3, 2}},
+	}
+
+	t.Run("given tag range should return non-merge commits after the tag newest first", func(t *testing.T) {
+		t.Parallel()
+
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		iter, err := repo.Log(&git.LogOptions{})
+		require.NoError(t, err)
+		var second *object.Commit
+		err = iter.ForEach(func(c *object.Commit) error {
+			if c.Message == "feat: second" {
+				second = c
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.NotNil(t, second)
+
+		tagger := &object.Signature{Name: "Gitcha", Email: "gitcha@gitcha.com", When: start}
+		_, err = repo.CreateTag("v1.0.0", second.Hash, &git.CreateTagOptions{Tagger: tagger, Message: "v1.0.0"})
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetCommitRange("v1.0.0", "HEAD")
+		require.NoError(t, err)
+
+		messages := make([]string, 0, len(actual))
+		for _, commit := range actual {
+			messages = append(messages, commit.Message)
+		}
+		assert.Equal(t, []string{"feat: main", "fix: branch"}, messages)
+	})
+
+	t.Run("given empty from should return every non-merge commit reachable from to", func(t *testing.T) {
+		t.Parallel()
+
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetCommitRange("", "")
+		require.NoError(t, err)
+
+		assert.Len(t, actual, 4)
+	})
+
+	t.Run("given unknown revision should return error", func(t *testing.T) {
+		t.Parallel()
+
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		_, err = repoReader.GetCommitRange("v9.9.9", "HEAD")
+
+		assert.Error(t, err)
+	})
+}
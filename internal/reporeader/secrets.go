@@ -0,0 +1,271 @@
+package reporeader
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/djyuhn/gitcha/internal/secrets"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/object/commitgraph"
+	"github.com/go-git/go-git/v5/utils/diff"
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+// SecretFinding is a possible secret added to a file by a commit.
+//
+// Secret is redacted so the report does not leak it again. Line is the line of the file, counted from 1, the secret
+// was added on, and AtHead is set when the scanner still finds the secret in a file at HEAD, at any path.
+type SecretFinding struct {
+	Rule        string    `json:"rule"`
+	Description string    `json:"description"`
+	Hash        string    `json:"hash"`
+	Author      Author    `json:"author"`
+	Date        time.Time `json:"date"`
+	Path        string    `json:"path"`
+	Line        int       `json:"line"`
+	Secret      string    `json:"secret"`
+	AtHead      bool      `json:"atHead"`
+}
+
+// Secrets reports the possible secrets found in the lines added by the commits reachable from HEAD or from any branch,
+// remote-tracking branch or tag, from the newest commit of HEAD to the oldest and then the commits of the other
+// references. Commits counts the commits scanned.
+type Secrets struct {
+	Commits  int             `json:"commits"`
+	Findings []SecretFinding `json:"findings"`
+}
+
+// AtHead returns the number of findings still present at HEAD.
+func (s Secrets) AtHead() int {
+	count := 0
+	for _, finding := range s.Findings {
+		if finding.AtHead {
+			count++
+		}
+	}
+
+	return count
+}
+
+// getSecrets scans the lines added by every commit relative to its first parent. The lines a merge adds that are in
+// the file at the same path in one of its other parents were brought in by the merged commits and are not scanned
+// again, leaving the lines written while resolving the merge. Binary files, submodules and the files the scanner skips
+// are not scanned.
+func (r *RepoReader) getSecrets(commits []*object.Commit, scanner *secrets.Scanner) (Secrets, error) {
+	found := Secrets{Findings: make([]SecretFinding, 0)}
+	if len(commits) == 0 {
+		return found, nil
+	}
+
+	head, err := r.repository.Head()
+	if err != nil {
+		return Secrets{}, fmt.Errorf("getSecrets: unable to get the repository head: %w", err)
+	}
+	headCommit, err := r.repository.CommitObject(head.Hash())
+	if err != nil {
+		return Secrets{}, fmt.Errorf("getSecrets: unable to read the head commit: %w", err)
+	}
+	headFiles := &headSecrets{commit: headCommit}
+
+	for _, commit := range commits {
+		found.Commits++
+
+		node, err := r.commitNode(commit)
+		if err != nil {
+			return Secrets{}, fmt.Errorf("getSecrets: %w", err)
+		}
+
+		changes, err := r.getFirstParentChanges(node)
+		if err != nil {
+			return Secrets{}, fmt.Errorf("getSecrets: %w", err)
+		}
+
+		for _, change := range changes {
+			if change.To.Name == "" || scanner.Skips(change.To.Name) {
+				continue
+			}
+
+			added, err := r.getAddedLines(change.Change)
+			if err != nil {
+				return Secrets{}, fmt.Errorf("getSecrets: unable to read %s at commit %s: %w", change.To.Name, commit.Hash, err)
+			}
+			if node.NumParents() > 1 {
+				added, err = r.withoutMergedLines(node, change.To.Name, added)
+				if err != nil {
+					return Secrets{}, fmt.Errorf("getSecrets: %w", err)
+				}
+			}
+
+			for _, line := range added {
+				for _, match := range scanner.Scan(line.text) {
+					atHead, err := r.containedAtHead(headFiles, scanner, match.Secret)
+					if err != nil {
+						return Secrets{}, fmt.Errorf("getSecrets: %w", err)
+					}
+
+					found.Findings = append(found.Findings, SecretFinding{
+						Rule:        match.Rule.ID,
+						Description: match.Rule.Description,
+						Hash:        commit.Hash.String(),
+						Author:      Author{Name: commit.Author.Name, Email: commit.Author.Email},
+						Date:        commit.Author.When,
+						Path:        change.To.Name,
+						Line:        line.number,
+						Secret:      secrets.Redact(match.Secret),
+						AtHead:      atHead,
+					})
+				}
+			}
+		}
+	}
+
+	return found, nil
+}
+
+// withoutMergedLines returns the lines of added, added to the file at path by the merge node, that are not in the
+// file at path in any parent of node other than the first.
+func (r *RepoReader) withoutMergedLines(node commitgraph.CommitNode, path string, added []addedLine) ([]addedLine, error) {
+	merged := make(map[string]bool)
+	for i := 1; i < node.NumParents(); i++ {
+		tree, err := getParentTree(node, i)
+		if err != nil {
+			return nil, err
+		}
+
+		entry, err := tree.FindEntry(path)
+		if errors.Is(err, object.ErrEntryNotFound) || errors.Is(err, object.ErrDirectoryNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to find %s in parent %d of commit %s: %w", path, i, node.ID(), err)
+		}
+		if !entry.Mode.IsFile() {
+			continue
+		}
+
+		content, isBinary, err := r.readBlob(entry.Hash)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read %s in parent %d of commit %s: %w", path, i, node.ID(), err)
+		}
+		if isBinary {
+			continue
+		}
+		for _, line := range strings.Split(content, "\n") {
+			merged[line] = true
+		}
+	}
+
+	resolved := make([]addedLine, 0, len(added))
+	for _, line := range added {
+		if !merged[line.text] {
+			resolved = append(resolved, line)
+		}
+	}
+
+	return resolved, nil
+}
+
+// addedLine is a line added by a change along with its line number in the new file.
+type addedLine struct {
+	number int
+	text   string
+}
+
+// getAddedLines returns the lines added by change. Binary files and submodules have no lines.
+func (r *RepoReader) getAddedLines(change *object.Change) ([]addedLine, error) {
+	from, err := r.readChangeSide(change.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := r.readChangeSide(change.To)
+	if err != nil {
+		return nil, err
+	}
+
+	added := make([]addedLine, 0)
+	number := 1
+	for _, d := range diff.Do(from, to) {
+		switch d.Type {
+		case diffmatchpatch.DiffInsert:
+			for _, text := range strings.SplitAfter(d.Text, "\n") {
+				if text == "" {
+					continue
+				}
+				added = append(added, addedLine{number: number, text: strings.TrimSuffix(text, "\n")})
+				number++
+			}
+		case diffmatchpatch.DiffEqual:
+			number += countLines(d.Text)
+		case diffmatchpatch.DiffDelete:
+		}
+	}
+
+	return added, nil
+}
+
+// headSecrets holds the secrets the scanner finds in the text files of the HEAD commit, scanned on first use.
+type headSecrets struct {
+	commit  *object.Commit
+	secrets map[string]bool
+}
+
+// containedAtHead returns whether the scanner finds secret in a text file at HEAD, at any path, so that a secret in a
+// file renamed or copied since it was added is still found. The files at HEAD are scanned once, each blob only once
+// however many paths hold it, and only the secrets found are kept.
+func (r *RepoReader) containedAtHead(head *headSecrets, scanner *secrets.Scanner, secret string) (bool, error) {
+	if head.secrets == nil {
+		iter, err := head.commit.Files()
+		if err != nil {
+			return false, fmt.Errorf("containedAtHead: unable to list the files at HEAD: %w", err)
+		}
+
+		found := make(map[string]bool)
+		scanned := make(map[plumbing.Hash]bool)
+		err = iter.ForEach(func(file *object.File) error {
+			if scanned[file.Hash] || scanner.Skips(file.Name) {
+				return nil
+			}
+			scanned[file.Hash] = true
+
+			content, isBinary, err := r.readBlob(file.Hash)
+			if err != nil {
+				return fmt.Errorf("unable to read %s at HEAD: %w", file.Name, err)
+			}
+			if isBinary {
+				return nil
+			}
+			for _, line := range strings.Split(content, "\n") {
+				for _, match := range scanner.Scan(line) {
+					found[match.Secret] = true
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return false, fmt.Errorf("containedAtHead: %w", err)
+		}
+		head.secrets = found
+	}
+
+	return head.secrets[secret], nil
+}
+
+// GetSecrets scans the commits reachable from HEAD or from any branch, remote-tracking branch or tag for possible
+// secrets with scanner, so that secrets left on unmerged branches are found as well.
+func (r *RepoReader) GetSecrets(scanner *secrets.Scanner) (Secrets, error) {
+	commits, err := r.getAllCommits()
+	if err != nil {
+		return Secrets{}, fmt.Errorf("GetSecrets: %w", err)
+	}
+
+	found, err := r.getSecrets(commits, scanner)
+	if err != nil {
+		return Secrets{}, fmt.Errorf("GetSecrets: %w", err)
+	}
+
+	return found, nil
+}
@@ -0,0 +1,219 @@
+package reporeader
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	// versionPattern matches a semantic version, optionally prefixed with v, capturing the major, minor and patch
+	// versions and the pre-release and build metadata.
+	versionPattern = regexp.MustCompile(`^[vV]?(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+		`(?:-((?:0|[1-9]\d*|\d*[A-Za-z-][0-9A-Za-z-]*)(?:\.(?:0|[1-9]\d*|\d*[A-Za-z-][0-9A-Za-z-]*))*))?` +
+		`(?:\+([0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*))?$`)
+	// versionLikePattern matches tags that look like they are meant to be versions.
+	versionLikePattern = regexp.MustCompile(`^[vV]?\d`)
+)
+
+// Version is a semantic version as defined by https://semver.org.
+type Version struct {
+	Major      uint64   `json:"major"`
+	Minor      uint64   `json:"minor"`
+	Patch      uint64   `json:"patch"`
+	PreRelease []string `json:"preRelease,omitempty"`
+	Build      string   `json:"build,omitempty"`
+}
+
+// ParseVersion parses version as a semantic version. A leading v, as commonly used in tag names, is allowed.
+func ParseVersion(version string) (Version, error) {
+	match := versionPattern.FindStringSubmatch(version)
+	if match == nil {
+		return Version{}, fmt.Errorf("ParseVersion: %q is not a semantic version", version)
+	}
+
+	parsed := Version{Build: match[5]}
+	for i, number := range []*uint64{&parsed.Major, &parsed.Minor, &parsed.Patch} {
+		value, err := strconv.ParseUint(match[i+1], 10, 64)
+		if err != nil {
+			return Version{}, fmt.Errorf("ParseVersion: %q is not a semantic version: %w", version, err)
+		}
+		*number = value
+	}
+	if match[4] != "" {
+		parsed.PreRelease = strings.Split(match[4], ".")
+	}
+
+	return parsed, nil
+}
+
+// IsPreRelease returns whether the version is a pre-release.
+func (v Version) IsPreRelease() bool {
+	return len(v.PreRelease) > 0
+}
+
+func (v Version) String() string {
+	version := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.IsPreRelease() {
+		version += "-" + strings.Join(v.PreRelease, ".")
+	}
+	if v.Build != "" {
+		version += "+" + v.Build
+	}
+
+	return version
+}
+
+// Compare returns -1, 0 or 1 when the version has a lower, the same or a higher precedence than other. Build metadata
+// does not affect precedence.
+func (v Version) Compare(other Version) int {
+	for _, pair := range [][2]uint64{{v.Major, other.Major}, {v.Minor, other.Minor}, {v.Patch, other.Patch}} {
+		if pair[0] != pair[1] {
+			return compareUint(pair[0], pair[1])
+		}
+	}
+
+	// A version without pre-release identifiers has a higher precedence than any of its pre-releases.
+	switch {
+	case !v.IsPreRelease() && !other.IsPreRelease():
+		return 0
+	case !v.IsPreRelease():
+		return 1
+	case !other.IsPreRelease():
+		return -1
+	}
+
+	for i := 0; i < len(v.PreRelease) && i < len(other.PreRelease); i++ {
+		if result := comparePreReleaseIdentifier(v.PreRelease[i], other.PreRelease[i]); result != 0 {
+			return result
+		}
+	}
+
+	return compareUint(uint64(len(v.PreRelease)), uint64(len(other.PreRelease)))
+}
+
+// comparePreReleaseIdentifier compares numeric identifiers numerically and others in ASCII order, numeric
+// identifiers having a lower precedence than alphanumeric ones.
+func comparePreReleaseIdentifier(a, b string) int {
+	aNumber, aErr := strconv.ParseUint(a, 10, 64)
+	bNumber, bErr := strconv.ParseUint(b, 10, 64)
+
+	switch {
+	case aErr == nil && bErr == nil:
+		return compareUint(aNumber, bNumber)
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// VersionedTag is a tag whose name is a semantic version.
+type VersionedTag struct {
+	Tag     Tag     `json:"tag"`
+	Version Version `json:"version"`
+	// OutOfOrder is set when the tag was created after a tag with a higher version on the same major and minor
+	// release line, such as v1.2.3 tagged after v1.2.4 or v1.3.0-rc.1 after v1.3.0.
+	OutOfOrder bool `json:"outOfOrder"`
+}
+
+// Versions holds the semantic version analysis of the tags of the repository.
+//
+// Versioned tags are ordered by version precedence. Malformed holds the names of tags that look like versions, as
+// they start with a digit or a v followed by a digit, but are not semantic versions. Stable releases are counted as
+// major, minor or patch releases by the lowest version number they change: x.0.0, x.y.0 or x.y.z.
+type Versions struct {
+	Tags             []VersionedTag `json:"tags"`
+	Malformed        []string       `json:"malformed"`
+	OutOfOrder       []string       `json:"outOfOrder"`
+	MajorReleases    int            `json:"majorReleases"`
+	MinorReleases    int            `json:"minorReleases"`
+	PatchReleases    int            `json:"patchReleases"`
+	PreReleases      int            `json:"preReleases"`
+	LatestStable     string         `json:"latestStable,omitempty"`
+	LatestPreRelease string         `json:"latestPreRelease,omitempty"`
+}
+
+// getVersions analyzes the names of tags, ordered from the oldest to the newest, as semantic versions.
+func (r *RepoReader) getVersions(tags []Tag) Versions {
+	versions := Versions{
+		Tags:       make([]VersionedTag, 0, len(tags)),
+		Malformed:  make([]string, 0),
+		OutOfOrder: make([]string, 0),
+	}
+
+	// highest holds the highest version tagged so far on each major and minor release line.
+	highest := make(map[[2]uint64]Version)
+
+	for _, tag := range tags {
+		version, err := ParseVersion(tag.Name)
+		if err != nil {
+			if versionLikePattern.MatchString(tag.Name) {
+				versions.Malformed = append(versions.Malformed, tag.Name)
+			}
+			continue
+		}
+
+		versioned := VersionedTag{Tag: tag, Version: version}
+
+		line := [2]uint64{version.Major, version.Minor}
+		if previous, ok := highest[line]; ok && version.Compare(previous) < 0 {
+			versioned.OutOfOrder = true
+			versions.OutOfOrder = append(versions.OutOfOrder, tag.Name)
+		} else {
+			highest[line] = version
+		}
+
+		switch {
+		case version.IsPreRelease():
+			versions.PreReleases++
+		case version.Patch != 0:
+			versions.PatchReleases++
+		case version.Minor != 0:
+			versions.MinorReleases++
+		default:
+			versions.MajorReleases++
+		}
+
+		versions.Tags = append(versions.Tags, versioned)
+	}
+
+	sort.SliceStable(versions.Tags, func(i, j int) bool {
+		return versions.Tags[i].Version.Compare(versions.Tags[j].Version) < 0
+	})
+
+	for _, versioned := range versions.Tags {
+		if versioned.Version.IsPreRelease() {
+			versions.LatestPreRelease = versioned.Tag.Name
+		} else {
+			versions.LatestStable = versioned.Tag.Name
+		}
+	}
+
+	return versions
+}
+
+// GetVersions returns the semantic version analysis of the tags of the repository.
+func (r *RepoReader) GetVersions() (Versions, error) {
+	tags, err := r.getTags()
+	if err != nil {
+		return Versions{}, fmt.Errorf("GetVersions: %w", err)
+	}
+
+	return r.getVersions(tags), nil
+}
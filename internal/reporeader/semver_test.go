@@ -0,0 +1,126 @@
+package reporeader_test
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/djyuhn/gitcha/gittest"
+	"github.com/djyuhn/gitcha/internal/reporeader"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVersion(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given version with pre-release and build metadata should parse every part", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := reporeader.ParseVersion("v1.2.3-rc.1+build.5")
+
+		assert.NoError(t, err)
+		assert.Equal(t, reporeader.Version{Major: 1, Minor: 2, Patch: 3, PreRelease: []string{"rc", "1"}, Build: "build.5"}, actual)
+		assert.True(t, actual.IsPreRelease())
+		assert.Equal(t, "1.2.3-rc.1+build.5", actual.String())
+	})
+
+	t.Run("given invalid versions should return error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, version := range []string{"1.2", "v01.2.3", "1.2.3-", "1.2.3-01", "1.2.3.4", "release-1.2.3", "v1.2.3+"} {
+			_, err := reporeader.ParseVersion(version)
+
+			assert.Error(t, err, version)
+		}
+	})
+}
+
+func TestVersion_Compare(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given versions should order them by semantic version precedence", func(t *testing.T) {
+		t.Parallel()
+
+		// The expected order from the semantic versioning specification.
+		expected := []string{
+			"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2", "1.0.0-beta.11",
+			"1.0.0-rc.1", "1.0.0", "1.0.1", "1.1.0", "2.0.0",
+		}
+
+		versions := make([]reporeader.Version, 0, len(expected))
+		for i := len(expected) - 1; i >= 0; i-- {
+			version, err := reporeader.ParseVersion(expected[i])
+			require.NoError(t, err)
+			versions = append(versions, version)
+		}
+
+		sort.Slice(versions, func(i, j int) bool { return versions[i].Compare(versions[j]) < 0 })
+
+		actual := make([]string, 0, len(versions))
+		for _, version := range versions {
+			actual = append(actual, version.String())
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("given versions differing only by build metadata should have the same precedence", func(t *testing.T) {
+		t.Parallel()
+
+		a, err := reporeader.ParseVersion("1.0.0+one")
+		require.NoError(t, err)
+		b, err := reporeader.ParseVersion("1.0.0+two")
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, a.Compare(b))
+	})
+}
+
+func TestRepoReader_GetVersions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given tags should sort versions, flag bad tags and count releases by kind", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+		author := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: start}
+		commits := []gittest.LocalCommit{{Author: author, Message: "First", Files: map[string]string{"a.txt": "a\n"}}}
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		head, err := repo.Head()
+		require.NoError(t, err)
+
+		// Tags are dated by their tagger so the order they were created in is known.
+		tagNames := []string{"v1.0.0", "v1.1.0", "v1.1.2", "v1.1.1", "v2.0.0-rc.1", "v1.1.3", "v2.0.0", "v2.0", "nightly"}
+		for i, name := range tagNames {
+			tagger := &object.Signature{Name: "Releaser", Email: "releaser@gitcha.com", When: start.Add(time.Duration(i) * time.Hour)}
+			_, err = repo.CreateTag(name, head.Hash(), &git.CreateTagOptions{Tagger: tagger, Message: name})
+			require.NoError(t, err)
+		}
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetVersions()
+		require.NoError(t, err)
+
+		names := make([]string, 0, len(actual.Tags))
+		for _, versioned := range actual.Tags {
+			names = append(names, versioned.Tag.Name)
+		}
+		assert.Equal(t, []string{"v1.0.0", "v1.1.0", "v1.1.1", "v1.1.2", "v1.1.3", "v2.0.0-rc.1", "v2.0.0"}, names)
+
+		assert.Equal(t, []string{"v2.0"}, actual.Malformed)
+		assert.Equal(t, []string{"v1.1.1"}, actual.OutOfOrder)
+		assert.Equal(t, 2, actual.MajorReleases)
+		assert.Equal(t, 1, actual.MinorReleases)
+		assert.Equal(t, 3, actual.PatchReleases)
+		assert.Equal(t, 1, actual.PreReleases)
+		assert.Equal(t, "v2.0.0", actual.LatestStable)
+		assert.Equal(t, "v2.0.0-rc.1", actual.LatestPreRelease)
+	})
+}
@@ -0,0 +1,374 @@
+package reporeader
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/object/commitgraph"
+)
+
+// SignatureType is the kind of signature a commit carries in its gpgsig header.
+type SignatureType string
+
+const (
+	SignatureNone SignatureType = "none"
+	SignatureGPG  SignatureType = "gpg"
+	SignatureSSH  SignatureType = "ssh"
+	// SignatureX509 is an S/MIME signature made with gpgsm. It is reported but never verified.
+	SignatureX509 SignatureType = "x509"
+	// SignatureUnknown is a signature in a format gitcha does not recognize.
+	SignatureUnknown SignatureType = "unknown"
+)
+
+// SignatureStatus is the outcome of verifying a commit signature against the keyring.
+type SignatureStatus string
+
+const (
+	SignatureUnsigned SignatureStatus = "unsigned"
+	// SignatureUnchecked is a signature that was not verified because the keyring holds no keys of its type.
+	SignatureUnchecked SignatureStatus = "unchecked"
+	// SignatureGood is a valid signature made by a key of the keyring.
+	SignatureGood SignatureStatus = "good"
+	// SignatureUnknownKey is a signature made by a key outside the keyring.
+	SignatureUnknownKey SignatureStatus = "unknown key"
+	// SignatureBad is a signature that does not match the commit or cannot be read.
+	SignatureBad SignatureStatus = "bad"
+)
+
+// CommitSignature is the signature of a commit and the outcome of its verification. Signer is the identity of the
+// key of a good signature: the name of the OpenPGP identity or the principals of the SSH allowed signer.
+type CommitSignature struct {
+	Hash    string          `json:"hash"`
+	Author  Author          `json:"author"`
+	Date    time.Time       `json:"date"`
+	Subject string          `json:"subject"`
+	Type    SignatureType   `json:"type"`
+	Status  SignatureStatus `json:"status"`
+	Signer  string          `json:"signer,omitempty"`
+}
+
+// SigningRate counts the commits of an author, how many of them are signed and how many carry a good signature.
+type SigningRate struct {
+	Commits  int `json:"commits"`
+	Signed   int `json:"signed"`
+	Verified int `json:"verified"`
+}
+
+// Share returns the percentage of commits that are signed.
+func (s SigningRate) Share() float64 {
+	if s.Commits == 0 {
+		return 0
+	}
+
+	return float64(s.Signed) * percent / float64(s.Commits)
+}
+
+// VerifiedShare returns the percentage of commits with a good signature.
+func (s SigningRate) VerifiedShare() float64 {
+	if s.Commits == 0 {
+		return 0
+	}
+
+	return float64(s.Verified) * percent / float64(s.Commits)
+}
+
+// ProtectedBranch lists the commits of a protected branch that are not properly signed. Unsigned holds the commits
+// without a signature and Unverified, when the keyring is not empty, the signed commits whose signature is not good.
+type ProtectedBranch struct {
+	Name       string            `json:"name"`
+	Commits    int               `json:"commits"`
+	Unsigned   []CommitSignature `json:"unsigned"`
+	Unverified []CommitSignature `json:"unverified"`
+}
+
+// Signatures reports the signed commits of the repository, other than the commits of bots left out of the analysis.
+//
+// Checked is set when signatures were verified against a keyring. Types counts the commits by signature type,
+// Statuses by verification outcome and Authors holds the signing rate of every commit author by email. Protected lists
+// the commits of the protected branches, newest first, that are unsigned or not signed by a key of the keyring.
+type Signatures struct {
+	SigningRate
+	Checked   bool                    `json:"checked"`
+	Types     map[SignatureType]int   `json:"types"`
+	Statuses  map[SignatureStatus]int `json:"statuses"`
+	Authors   map[string]SigningRate  `json:"authors"`
+	Protected []ProtectedBranch       `json:"protected"`
+}
+
+// SortedAuthors returns the emails of the commit authors from the lowest to the highest signing rate, then by email.
+func (s Signatures) SortedAuthors() []string {
+	authors := make([]string, 0, len(s.Authors))
+	for email := range s.Authors {
+		authors = append(authors, email)
+	}
+
+	sort.Slice(authors, func(a, b int) bool {
+		shareA, shareB := s.Authors[authors[a]].Share(), s.Authors[authors[b]].Share()
+		if shareA != shareB {
+			return shareA < shareB
+		}
+		return authors[a] < authors[b]
+	})
+
+	return authors
+}
+
+// signatureTypeOf recognizes the type of an armored commit signature from its header.
+func signatureTypeOf(signature string) SignatureType {
+	switch {
+	case signature == "":
+		return SignatureNone
+	case strings.HasPrefix(signature, "-----BEGIN PGP SIGNATURE-----"):
+		return SignatureGPG
+	case strings.HasPrefix(signature, "-----BEGIN SSH SIGNATURE-----"):
+		return SignatureSSH
+	case strings.HasPrefix(signature, "-----BEGIN SIGNED MESSAGE-----"):
+		return SignatureX509
+	default:
+		return SignatureUnknown
+	}
+}
+
+// checkSignature identifies the signature of commit and verifies it against the keyring.
+func (r *RepoReader) checkSignature(commit *object.Commit) CommitSignature {
+	subject, _, _ := strings.Cut(commit.Message, "\n")
+	checked := CommitSignature{
+		Hash:    commit.Hash.String(),
+		Author:  Author{Name: commit.Author.Name, Email: commit.Author.Email},
+		Date:    commit.Author.When,
+		Subject: strings.TrimSpace(subject),
+		Type:    signatureTypeOf(commit.PGPSignature),
+		Status:  SignatureUnchecked,
+	}
+
+	var verify func(payload []byte, signature string) (string, error)
+	switch checked.Type {
+	case SignatureNone:
+		checked.Status = SignatureUnsigned
+		return checked
+	case SignatureGPG:
+		verify = r.keyring.verifyPGP
+	case SignatureSSH:
+		verify = r.keyring.verifySSH
+	case SignatureX509, SignatureUnknown:
+		return checked
+	}
+
+	payload, err := r.encodeWithoutSignature(commit)
+	if err != nil {
+		checked.Status = SignatureBad
+		return checked
+	}
+
+	signer, err := verify(payload, commit.PGPSignature)
+	switch {
+	case err == nil:
+		checked.Status = SignatureGood
+		checked.Signer = signer
+	case errors.Is(err, errNoKeys):
+	case errors.Is(err, errUnknownKey):
+		checked.Status = SignatureUnknownKey
+	default:
+		checked.Status = SignatureBad
+	}
+
+	return checked
+}
+
+// signatureHeader is the header of a commit holding its signature.
+const signatureHeader = "gpgsig "
+
+// encodeWithoutSignature returns the encoded commit a signature is made over: the commit as stored with only its
+// gpgsig header removed. The commit is read back from the storage rather than encoded again, as go-git drops the
+// headers it does not model, such as mergetag and encoding, which the signature covers.
+func (r *RepoReader) encodeWithoutSignature(commit *object.Commit) ([]byte, error) {
+	encoded, err := r.repository.Storer.EncodedObject(plumbing.CommitObject, commit.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("encodeWithoutSignature: unable to find commit %s: %w", commit.Hash, err)
+	}
+
+	reader, err := encoded.Reader()
+	if err != nil {
+		return nil, fmt.Errorf("encodeWithoutSignature: %w", err)
+	}
+	defer reader.Close()
+
+	raw, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("encodeWithoutSignature: %w", err)
+	}
+
+	return stripSignatureHeader(raw), nil
+}
+
+// stripSignatureHeader removes the gpgsig header and its continuation lines from the headers of the encoded commit
+// raw, leaving the other headers and the message untouched.
+func stripSignatureHeader(raw []byte) []byte {
+	payload := make([]byte, 0, len(raw))
+	inSignature := false
+	for len(raw) > 0 {
+		line := raw
+		if end := bytes.IndexByte(raw, '\n'); end >= 0 {
+			line = raw[:end+1]
+		}
+
+		if len(bytes.TrimSuffix(line, []byte("\n"))) == 0 {
+			// The headers end at the first empty line and the message follows it.
+			return append(payload, raw...)
+		}
+		if !inSignature || line[0] != ' ' {
+			inSignature = bytes.HasPrefix(line, []byte(signatureHeader))
+		}
+		if !inSignature {
+			payload = append(payload, line...)
+		}
+		raw = raw[len(line):]
+	}
+
+	return payload
+}
+
+// getSignatures checks the signatures of commits and of the commits of the protected branches.
+func (r *RepoReader) getSignatures(commits []*object.Commit) (Signatures, error) {
+	signatures := Signatures{
+		Checked:   !r.keyring.Empty(),
+		Types:     make(map[SignatureType]int),
+		Statuses:  make(map[SignatureStatus]int),
+		Authors:   make(map[string]SigningRate),
+		Protected: make([]ProtectedBranch, 0),
+	}
+	checked := make(map[plumbing.Hash]CommitSignature, len(commits))
+
+	for _, commit := range commits {
+		if r.excludesBot(commit.Author.Name, commit.Author.Email) {
+			continue
+		}
+
+		signature := r.checkSignature(commit)
+		checked[commit.Hash] = signature
+
+		author := signatures.Authors[commit.Author.Email]
+		author.Commits++
+		signatures.Commits++
+		if signature.Type != SignatureNone {
+			author.Signed++
+			signatures.Signed++
+		}
+		if signature.Status == SignatureGood {
+			author.Verified++
+			signatures.Verified++
+		}
+		signatures.Authors[commit.Author.Email] = author
+		signatures.Types[signature.Type]++
+		signatures.Statuses[signature.Status]++
+	}
+
+	branches, err := r.getProtectedBranches()
+	if err != nil {
+		return Signatures{}, fmt.Errorf("getSignatures: %w", err)
+	}
+
+	for _, branch := range branches {
+		protected, err := r.getProtectedBranch(branch, checked, signatures.Checked)
+		if err != nil {
+			return Signatures{}, fmt.Errorf("getSignatures: %w", err)
+		}
+		signatures.Protected = append(signatures.Protected, protected)
+	}
+
+	return signatures, nil
+}
+
+// getProtectedBranches returns the references of the protected branches that exist in the repository, or of the
+// default branch when no branch is protected explicitly. A protected branch is looked up as a local branch, then as a
+// branch of origin.
+func (r *RepoReader) getProtectedBranches() ([]*plumbing.Reference, error) {
+	if len(r.protectedBranches) == 0 {
+		branch, err := r.getDefaultBranch()
+		if err != nil {
+			return nil, fmt.Errorf("getProtectedBranches: %w", err)
+		}
+		return []*plumbing.Reference{branch}, nil
+	}
+
+	branches := make([]*plumbing.Reference, 0, len(r.protectedBranches))
+	for _, name := range r.protectedBranches {
+		for _, refName := range []plumbing.ReferenceName{
+			plumbing.NewBranchReferenceName(name),
+			plumbing.NewRemoteReferenceName("origin", name),
+		} {
+			ref, err := r.repository.Reference(refName, true)
+			if err == nil {
+				branches = append(branches, ref)
+				break
+			}
+		}
+	}
+
+	return branches, nil
+}
+
+// getProtectedBranch walks the commits of a protected branch and lists the ones that are not properly signed,
+// reusing the signatures already checked for the history of HEAD. Signed commits are only listed as unverified when
+// the signatures were verified against a keyring.
+func (r *RepoReader) getProtectedBranch(branch *plumbing.Reference, checked map[plumbing.Hash]CommitSignature, verified bool) (ProtectedBranch, error) {
+	protected := ProtectedBranch{
+		Name:       branch.Name().Short(),
+		Unsigned:   make([]CommitSignature, 0),
+		Unverified: make([]CommitSignature, 0),
+	}
+
+	err := r.walkCommitNodes(branch.Hash(), func(node commitgraph.CommitNode) error {
+		signature, ok := checked[node.ID()]
+		if !ok {
+			commit, err := node.Commit()
+			if err != nil {
+				return fmt.Errorf("unable to read commit %s: %w", node.ID(), err)
+			}
+			if r.excludesBot(commit.Author.Name, commit.Author.Email) {
+				return nil
+			}
+			signature = r.checkSignature(commit)
+		}
+
+		protected.Commits++
+		switch signature.Status {
+		case SignatureUnsigned:
+			protected.Unsigned = append(protected.Unsigned, signature)
+		case SignatureUnknownKey, SignatureBad, SignatureUnchecked:
+			if verified {
+				protected.Unverified = append(protected.Unverified, signature)
+			}
+		case SignatureGood:
+		}
+		return nil
+	})
+	if err != nil {
+		return ProtectedBranch{}, fmt.Errorf("getProtectedBranch: %w", err)
+	}
+
+	return protected, nil
+}
+
+// GetSignatures returns the signed commits of the repository, verified against the keyring, and the commits of the
+// protected branches that are not properly signed.
+func (r *RepoReader) GetSignatures() (Signatures, error) {
+	commits, err := r.getCommits()
+	if err != nil {
+		return Signatures{}, fmt.Errorf("GetSignatures: %w", err)
+	}
+
+	signatures, err := r.getSignatures(commits)
+	if err != nil {
+		return Signatures{}, fmt.Errorf("GetSignatures: %w", err)
+	}
+
+	return signatures, nil
+}
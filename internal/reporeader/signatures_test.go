@@ -0,0 +1,287 @@
+package reporeader_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/djyuhn/gitcha/gittest"
+	"github.com/djyuhn/gitcha/internal/reporeader"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestRepoReader_GetSignatures(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+	authorOne := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: start}
+	authorTwo := object.Signature{Name: "Gitcha Two", Email: "gitcha2@gitcha.com", When: start.Add(time.Hour)}
+
+	pgpKey, err := openpgp.NewEntity("Gitcha One", "", authorOne.Email, nil)
+	require.NoError(t, err)
+	otherPGPKey, err := openpgp.NewEntity("Gitcha Other", "", "other@gitcha.com", nil)
+	require.NoError(t, err)
+	sshPublicKey, sshKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	commits := []gittest.LocalCommit{
+		{Author: authorOne, Message: "Initial commit", Files: map[string]string{"README.md": "# gitcha\n"}, Sign: signPGP(t, pgpKey)},
+		{Author: authorTwo, Message: "Add the cache", Files: map[string]string{"cache.go": "package cache\n"}, Sign: signSSH(t, sshKey)},
+		{Author: authorTwo, Message: "Evict stale entries", Files: map[string]string{"cache.go": "package cache\n// evict\n"}},
+		{Author: authorOne, Message: "Add the tree view", Files: map[string]string{"tree.go": "package tree\n"}, Sign: signPGP(t, otherPGPKey)},
+	}
+
+	t.Run("given no keyring should detect signatures without verifying them", func(t *testing.T) {
+		t.Parallel()
+
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetSignatures()
+		require.NoError(t, err)
+
+		assert.False(t, actual.Checked)
+		assert.Equal(t, reporeader.SigningRate{Commits: 4, Signed: 3}, actual.SigningRate)
+		assert.Equal(t, map[reporeader.SignatureType]int{
+			reporeader.SignatureGPG:  2,
+			reporeader.SignatureSSH:  1,
+			reporeader.SignatureNone: 1,
+		}, actual.Types)
+		assert.Equal(t, map[reporeader.SignatureStatus]int{
+			reporeader.SignatureUnchecked: 3,
+			reporeader.SignatureUnsigned:  1,
+		}, actual.Statuses)
+		assert.Equal(t, map[string]reporeader.SigningRate{
+			authorOne.Email: {Commits: 2, Signed: 2},
+			authorTwo.Email: {Commits: 2, Signed: 1},
+		}, actual.Authors)
+		assert.Equal(t, []string{authorTwo.Email, authorOne.Email}, actual.SortedAuthors())
+
+		require.Len(t, actual.Protected, 1)
+		assert.Equal(t, "master", actual.Protected[0].Name)
+		assert.Equal(t, 4, actual.Protected[0].Commits)
+		require.Len(t, actual.Protected[0].Unsigned, 1)
+		assert.Equal(t, "Evict stale entries", actual.Protected[0].Unsigned[0].Subject)
+		assert.Empty(t, actual.Protected[0].Unverified)
+	})
+
+	t.Run("given a keyring should verify GPG and SSH signatures", func(t *testing.T) {
+		t.Parallel()
+
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		dir := t.TempDir()
+		pgpKeyring := filepath.Join(dir, "pubring.asc")
+		sshKeyring := filepath.Join(dir, "allowed_signers")
+		require.NoError(t, os.WriteFile(pgpKeyring, armoredPublicKey(t, pgpKey), 0o600))
+		require.NoError(t, os.WriteFile(sshKeyring, allowedSigners(t, authorTwo.Email, sshPublicKey), 0o600))
+
+		keyring, err := reporeader.ReadKeyring(pgpKeyring, sshKeyring)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo, reporeader.WithKeyring(keyring))
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetSignatures()
+		require.NoError(t, err)
+
+		assert.True(t, actual.Checked)
+		assert.Equal(t, reporeader.SigningRate{Commits: 4, Signed: 3, Verified: 2}, actual.SigningRate)
+		assert.Equal(t, map[reporeader.SignatureStatus]int{
+			reporeader.SignatureGood:       2,
+			reporeader.SignatureUnknownKey: 1,
+			reporeader.SignatureUnsigned:   1,
+		}, actual.Statuses)
+		assert.InDelta(t, 50, actual.Authors[authorOne.Email].VerifiedShare(), 0.01)
+
+		require.Len(t, actual.Protected, 1)
+		require.Len(t, actual.Protected[0].Unverified, 1)
+		assert.Equal(t, "Add the tree view", actual.Protected[0].Unverified[0].Subject)
+		assert.Equal(t, reporeader.SignatureUnknownKey, actual.Protected[0].Unverified[0].Status)
+	})
+
+	t.Run("given a tampered signature should report it as bad", func(t *testing.T) {
+		t.Parallel()
+
+		tampered := []gittest.LocalCommit{{
+			Author:  authorTwo,
+			Message: "Add the cache",
+			Files:   map[string]string{"cache.go": "package cache\n"},
+			Sign: func(payload []byte) (string, error) {
+				return signSSH(t, sshKey)(append(payload, "tampered"...))
+			},
+		}}
+		_, repo, err := gittest.CreateLocalRepo(t, tampered)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo,
+			reporeader.WithKeyring(mustParseKeyring(t, allowedSigners(t, authorTwo.Email, sshPublicKey))))
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetSignatures()
+		require.NoError(t, err)
+
+		assert.Equal(t, map[reporeader.SignatureStatus]int{reporeader.SignatureBad: 1}, actual.Statuses)
+	})
+
+	t.Run("given a signed commit with headers go-git does not model should verify the signature over them", func(t *testing.T) {
+		t.Parallel()
+
+		_, repo, err := gittest.CreateLocalRepo(t, commits[:1])
+		require.NoError(t, err)
+		head, err := repo.Head()
+		require.NoError(t, err)
+		initial, err := repo.CommitObject(head.Hash())
+		require.NoError(t, err)
+
+		headers := fmt.Sprintf("tree %s\nparent %s\nauthor %s <%s> %d +0000\ncommitter %s <%s> %d +0000\nencoding ISO-8859-1\n",
+			initial.TreeHash, initial.Hash, authorTwo.Name, authorTwo.Email, authorTwo.When.Unix(),
+			authorTwo.Name, authorTwo.Email, authorTwo.When.Unix())
+		message := "\nRe-encode the readme\n"
+		signature, err := signSSH(t, sshKey)([]byte(headers + message))
+		require.NoError(t, err)
+		signatureHeader := "gpgsig " + strings.ReplaceAll(strings.TrimSuffix(signature, "\n"), "\n", "\n ") + "\n"
+
+		signed := repo.Storer.NewEncodedObject()
+		signed.SetType(plumbing.CommitObject)
+		writer, err := signed.Writer()
+		require.NoError(t, err)
+		_, err = writer.Write([]byte(headers + signatureHeader + message))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+		hash, err := repo.Storer.SetEncodedObject(signed)
+		require.NoError(t, err)
+		require.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference(head.Name(), hash)))
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo,
+			reporeader.WithKeyring(mustParseKeyring(t, allowedSigners(t, authorTwo.Email, sshPublicKey))))
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetSignatures()
+		require.NoError(t, err)
+
+		assert.Equal(t, map[reporeader.SignatureStatus]int{
+			reporeader.SignatureGood:      1,
+			reporeader.SignatureUnchecked: 1,
+		}, actual.Statuses)
+	})
+
+	t.Run("given protected branches should report the unsigned commits of the existing ones", func(t *testing.T) {
+		t.Parallel()
+
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+		hashes := commitHashesByMessage(t, repo)
+		ref := plumbing.NewHashReference(plumbing.NewBranchReferenceName("release"), hashes["Add the cache"])
+		require.NoError(t, repo.Storer.SetReference(ref))
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo, reporeader.WithProtectedBranches("release", "missing"))
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetSignatures()
+		require.NoError(t, err)
+
+		require.Len(t, actual.Protected, 1)
+		assert.Equal(t, "release", actual.Protected[0].Name)
+		assert.Equal(t, 2, actual.Protected[0].Commits)
+		assert.Empty(t, actual.Protected[0].Unsigned)
+	})
+}
+
+// signPGP returns a signer making armored detached OpenPGP signatures like git commit -S does with gpg.
+func signPGP(t *testing.T, entity *openpgp.Entity) func(payload []byte) (string, error) {
+	t.Helper()
+
+	return func(payload []byte) (string, error) {
+		var signature bytes.Buffer
+		if err := openpgp.ArmoredDetachSign(&signature, entity, bytes.NewReader(payload), nil); err != nil {
+			return "", err
+		}
+		return signature.String() + "\n", nil
+	}
+}
+
+// signSSH returns a signer making armored SSH signatures in the git namespace like git commit -S does with
+// gpg.format set to ssh.
+func signSSH(t *testing.T, key ed25519.PrivateKey) func(payload []byte) (string, error) {
+	t.Helper()
+
+	return func(payload []byte) (string, error) {
+		signer, err := ssh.NewSignerFromKey(key)
+		if err != nil {
+			return "", err
+		}
+
+		digest := sha512.Sum512(payload)
+		signed := append([]byte("SSHSIG"), ssh.Marshal(struct {
+			Namespace     string
+			Reserved      string
+			HashAlgorithm string
+			Hash          []byte
+		}{"git", "", "sha512", digest[:]})...)
+		signature, err := signer.Sign(rand.Reader, signed)
+		if err != nil {
+			return "", err
+		}
+
+		blob := append([]byte("SSHSIG"), ssh.Marshal(struct {
+			Version       uint32
+			PublicKey     []byte
+			Namespace     string
+			Reserved      string
+			HashAlgorithm string
+			Signature     []byte
+		}{1, signer.PublicKey().Marshal(), "git", "", "sha512", ssh.Marshal(signature)})...)
+
+		return string(pem.EncodeToMemory(&pem.Block{Type: "SSH SIGNATURE", Bytes: blob})), nil
+	}
+}
+
+func armoredPublicKey(t *testing.T, entity *openpgp.Entity) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.Serialize(w))
+	require.NoError(t, w.Close())
+
+	return buf.Bytes()
+}
+
+func allowedSigners(t *testing.T, email string, key ed25519.PublicKey) []byte {
+	t.Helper()
+
+	publicKey, err := ssh.NewPublicKey(key)
+	require.NoError(t, err)
+
+	return []byte(fmt.Sprintf("# allowed signers\n%s %s", email, ssh.MarshalAuthorizedKey(publicKey)))
+}
+
+func mustParseKeyring(t *testing.T, data []byte) reporeader.Keyring {
+	t.Helper()
+
+	keyring, err := reporeader.ParseKeyring(data)
+	require.NoError(t, err)
+
+	return keyring
+}
@@ -0,0 +1,377 @@
+package reporeader
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/object/commitgraph"
+	"github.com/go-git/go-git/v5/storage/filesystem"
+)
+
+// DefaultLargeBlobCount is the number of largest blobs reported by default.
+const DefaultLargeBlobCount = 10
+
+// looseObjectDirLength is the length of the names of the directories loose objects are stored in, the first two hex
+// characters of their hash.
+const looseObjectDirLength = 2
+
+// LargeBlob is one of the largest blobs committed to the history of HEAD along with where it first appeared.
+//
+// Path, Commit, Author and Date describe the oldest commit found adding the blob and the path it was added at. AtHead
+// is set when the tree of HEAD still holds the blob, at any path, and Binary when its content is binary, which makes
+// it a candidate for Git LFS.
+type LargeBlob struct {
+	Hash   string    `json:"hash"`
+	Size   int64     `json:"size"`
+	Path   string    `json:"path"`
+	Commit string    `json:"commit"`
+	Author Author    `json:"author"`
+	Date   time.Time `json:"date"`
+	AtHead bool      `json:"atHead"`
+	Binary bool      `json:"binary"`
+}
+
+// ObjectStats counts objects and their size in bytes.
+type ObjectStats struct {
+	Count int   `json:"count"`
+	Size  int64 `json:"size"`
+}
+
+// RepoSize breaks down the size of the object database.
+//
+// Objects holds the number and uncompressed size of the objects of every type reachable from HEAD, along with the
+// annotated tags, keyed by type name such as blob. Loose and Packs hold the number and size on disk of the loose
+// objects and pack files, unreachable objects included, and are empty for repositories that are not stored on a
+// filesystem. HeadBlobs are the blobs of the tree of HEAD and HistoryBlobs the blobs committed to its history that are
+// no longer in it, the size a history rewrite could reclaim. LargestBlobs are the largest blobs committed to the
+// history of HEAD from the largest.
+type RepoSize struct {
+	Objects      map[string]ObjectStats `json:"objects"`
+	Loose        ObjectStats            `json:"loose"`
+	Packs        ObjectStats            `json:"packs"`
+	HeadBlobs    ObjectStats            `json:"headBlobs"`
+	HistoryBlobs ObjectStats            `json:"historyBlobs"`
+	LargestBlobs []LargeBlob            `json:"largestBlobs"`
+}
+
+// DiskSize returns the size on disk of the loose objects and pack files.
+func (s RepoSize) DiskSize() int64 {
+	return s.Loose.Size + s.Packs.Size
+}
+
+// objectSizer is implemented by storages that read the size of an object without reading its content.
+type objectSizer interface {
+	EncodedObjectSize(hash plumbing.Hash) (int64, error)
+}
+
+// objectWalk holds the objects of the history found so far while measuring it.
+type objectWalk struct {
+	objects map[string]ObjectStats
+	trees   map[plumbing.Hash]bool
+	blobs   map[plumbing.Hash]LargeBlob
+}
+
+// add counts an object of objectType.
+func (w *objectWalk) add(objectType plumbing.ObjectType, size int64) {
+	stats := w.objects[objectType.String()]
+	stats.Count++
+	stats.Size += size
+	w.objects[objectType.String()] = stats
+}
+
+// getRepoSize measures the objects of history and finds the largest blobs committed to it.
+//
+// The trees of the commits are walked from the root commits forward, skipping the trees already walked, so that every
+// object is read once and the first commit found holding a blob is the commit that introduced it. Object sizes are
+// read from the object headers without inflating their content, and only the commits introducing the reported blobs
+// are inflated for their author.
+func (r *RepoReader) getRepoSize(history *commitHistory) (RepoSize, error) {
+	size := RepoSize{Objects: make(map[string]ObjectStats), LargestBlobs: make([]LargeBlob, 0)}
+	if len(history.nodes) == 0 {
+		return size, nil
+	}
+
+	if storage, ok := r.repository.Storer.(*filesystem.Storage); ok {
+		var err error
+		size.Loose, size.Packs, err = measureObjectsDir(storage)
+		if err != nil {
+			return RepoSize{}, fmt.Errorf("getRepoSize: %w", err)
+		}
+	}
+
+	walk := &objectWalk{
+		objects: size.Objects,
+		trees:   make(map[plumbing.Hash]bool),
+		blobs:   make(map[plumbing.Hash]LargeBlob),
+	}
+	for _, node := range topologicalOrder(history.nodes) {
+		if err := r.walkCommitObjects(node, walk); err != nil {
+			return RepoSize{}, fmt.Errorf("getRepoSize: %w", err)
+		}
+	}
+	if err := r.walkTagObjects(walk); err != nil {
+		return RepoSize{}, fmt.Errorf("getRepoSize: %w", err)
+	}
+
+	headTree, err := history.nodes[0].Tree()
+	if err != nil {
+		return RepoSize{}, fmt.Errorf("getRepoSize: unable to read tree of commit %s: %w", history.nodes[0].ID(), err)
+	}
+	headBlobs, err := getTreeBlobs(headTree)
+	if err != nil {
+		return RepoSize{}, fmt.Errorf("getRepoSize: %w", err)
+	}
+
+	blobs := make([]LargeBlob, 0, len(walk.blobs))
+	for hash, blob := range walk.blobs {
+		blob.AtHead = headBlobs[hash]
+		if blob.AtHead {
+			size.HeadBlobs.Count++
+			size.HeadBlobs.Size += blob.Size
+		} else {
+			size.HistoryBlobs.Count++
+			size.HistoryBlobs.Size += blob.Size
+		}
+		blobs = append(blobs, blob)
+	}
+
+	sort.Slice(blobs, func(i, j int) bool {
+		if blobs[i].Size != blobs[j].Size {
+			return blobs[i].Size > blobs[j].Size
+		}
+		return blobs[i].Hash < blobs[j].Hash
+	})
+	if len(blobs) > r.largeBlobCount {
+		blobs = blobs[:r.largeBlobCount]
+	}
+
+	for i := range blobs {
+		commit, err := r.repository.CommitObject(plumbing.NewHash(blobs[i].Commit))
+		if err != nil {
+			return RepoSize{}, fmt.Errorf("getRepoSize: unable to read commit %s: %w", blobs[i].Commit, err)
+		}
+		blobs[i].Author = Author{Name: commit.Author.Name, Email: commit.Author.Email}
+		blobs[i].Date = commit.Author.When
+
+		blobs[i].Binary, err = r.isBinaryBlob(plumbing.NewHash(blobs[i].Hash))
+		if err != nil {
+			return RepoSize{}, fmt.Errorf("getRepoSize: %w", err)
+		}
+	}
+	size.LargestBlobs = blobs
+
+	return size, nil
+}
+
+// walkCommitObjects counts the commit of node and the trees and blobs of its tree not found in an earlier commit,
+// recording node as the commit introducing those blobs.
+func (r *RepoReader) walkCommitObjects(node commitgraph.CommitNode, walk *objectWalk) error {
+	commitSize, err := r.getObjectSize(node.ID())
+	if err != nil {
+		return err
+	}
+	walk.add(plumbing.CommitObject, commitSize)
+
+	tree, err := node.Tree()
+	if err != nil {
+		return fmt.Errorf("unable to read tree of commit %s: %w", node.ID(), err)
+	}
+
+	return r.walkTreeObjects(tree, "", node.ID(), walk)
+}
+
+// walkTreeObjects counts tree and the trees and blobs beneath it, unless tree was already walked. New blobs are
+// recorded as introduced by commit at their path below dirPath.
+func (r *RepoReader) walkTreeObjects(tree *object.Tree, dirPath string, commit plumbing.Hash, walk *objectWalk) error {
+	if walk.trees[tree.Hash] {
+		return nil
+	}
+	walk.trees[tree.Hash] = true
+
+	treeSize, err := r.getObjectSize(tree.Hash)
+	if err != nil {
+		return err
+	}
+	walk.add(plumbing.TreeObject, treeSize)
+
+	for _, entry := range tree.Entries {
+		entryPath := path.Join(dirPath, entry.Name)
+
+		switch {
+		case entry.Mode == filemode.Dir:
+			if walk.trees[entry.Hash] {
+				continue
+			}
+			subtree, err := object.GetTree(r.repository.Storer, entry.Hash)
+			if err != nil {
+				return fmt.Errorf("unable to read tree %s: %w", entryPath, err)
+			}
+			if err := r.walkTreeObjects(subtree, entryPath, commit, walk); err != nil {
+				return err
+			}
+		case entry.Mode.IsFile():
+			if _, ok := walk.blobs[entry.Hash]; ok {
+				continue
+			}
+			blobSize, err := r.getObjectSize(entry.Hash)
+			if err != nil {
+				return err
+			}
+			walk.add(plumbing.BlobObject, blobSize)
+			walk.blobs[entry.Hash] = LargeBlob{Hash: entry.Hash.String(), Size: blobSize, Path: entryPath, Commit: commit.String()}
+		}
+	}
+
+	return nil
+}
+
+// walkTagObjects counts the annotated tags of the repository.
+func (r *RepoReader) walkTagObjects(walk *objectWalk) error {
+	tags, err := r.repository.TagObjects()
+	if err != nil {
+		return fmt.Errorf("unable to list the annotated tags: %w", err)
+	}
+
+	err = tags.ForEach(func(tag *object.Tag) error {
+		tagSize, err := r.getObjectSize(tag.Hash)
+		if err != nil {
+			return err
+		}
+		walk.add(plumbing.TagObject, tagSize)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to read the annotated tags: %w", err)
+	}
+
+	return nil
+}
+
+// getTreeBlobs returns the set of blobs in tree and the trees beneath it.
+func getTreeBlobs(tree *object.Tree) (map[plumbing.Hash]bool, error) {
+	blobs := make(map[plumbing.Hash]bool)
+	walker := object.NewTreeWalker(tree, true, nil)
+	defer walker.Close()
+	for {
+		_, entry, err := walker.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("getTreeBlobs: unable to walk tree %s: %w", tree.Hash, err)
+		}
+		if entry.Mode.IsFile() {
+			blobs[entry.Hash] = true
+		}
+	}
+
+	return blobs, nil
+}
+
+// getObjectSize returns the size of an object, without reading its content when the storage allows it.
+func (r *RepoReader) getObjectSize(hash plumbing.Hash) (int64, error) {
+	if sizer, ok := r.repository.Storer.(objectSizer); ok {
+		size, err := sizer.EncodedObjectSize(hash)
+		if err != nil {
+			return 0, fmt.Errorf("getObjectSize: unable to find object %s: %w", hash, err)
+		}
+		return size, nil
+	}
+
+	obj, err := r.repository.Storer.EncodedObject(plumbing.AnyObject, hash)
+	if err != nil {
+		return 0, fmt.Errorf("getObjectSize: unable to find object %s: %w", hash, err)
+	}
+
+	return obj.Size(), nil
+}
+
+// isBinaryBlob returns whether the leading bytes of a blob contain a NUL byte, like git does to detect binary files.
+func (r *RepoReader) isBinaryBlob(hash plumbing.Hash) (bool, error) {
+	blob, err := r.repository.BlobObject(hash)
+	if err != nil {
+		return false, fmt.Errorf("isBinaryBlob: unable to find blob %s: %w", hash, err)
+	}
+
+	reader, err := blob.Reader()
+	if err != nil {
+		return false, fmt.Errorf("isBinaryBlob: unable to open blob %s: %w", hash, err)
+	}
+	defer reader.Close()
+
+	sniff, err := io.ReadAll(io.LimitReader(reader, binarySniffLength))
+	if err != nil {
+		return false, fmt.Errorf("isBinaryBlob: unable to read blob %s: %w", hash, err)
+	}
+
+	return bytes.IndexByte(sniff, 0) != -1, nil
+}
+
+// measureObjectsDir returns the number and size on disk of the loose objects and of the pack files of a repository
+// stored on a filesystem.
+func measureObjectsDir(storage *filesystem.Storage) (ObjectStats, ObjectStats, error) {
+	fs := storage.Filesystem()
+	loose, packs := ObjectStats{}, ObjectStats{}
+
+	dirs, err := fs.ReadDir("objects")
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return loose, packs, nil
+		}
+		return ObjectStats{}, ObjectStats{}, fmt.Errorf("measureObjectsDir: unable to read the objects directory: %w", err)
+	}
+
+	for _, dir := range dirs {
+		if !dir.IsDir() || len(dir.Name()) != looseObjectDirLength {
+			continue
+		}
+		files, err := fs.ReadDir(path.Join("objects", dir.Name()))
+		if err != nil {
+			return ObjectStats{}, ObjectStats{}, fmt.Errorf("measureObjectsDir: unable to read %s: %w", dir.Name(), err)
+		}
+		for _, file := range files {
+			loose.Count++
+			loose.Size += file.Size()
+		}
+	}
+
+	files, err := fs.ReadDir(path.Join("objects", "pack"))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return ObjectStats{}, ObjectStats{}, fmt.Errorf("measureObjectsDir: unable to read the pack directory: %w", err)
+	}
+	for _, file := range files {
+		if strings.HasSuffix(file.Name(), ".pack") {
+			packs.Count++
+			packs.Size += file.Size()
+		}
+	}
+
+	return loose, packs, nil
+}
+
+// GetRepoSize returns the size breakdown of the object database and the largest blobs committed to the history of
+// HEAD.
+func (r *RepoReader) GetRepoSize() (RepoSize, error) {
+	history, err := r.getHistory()
+	if err != nil {
+		return RepoSize{}, fmt.Errorf("GetRepoSize: %w", err)
+	}
+	defer history.close()
+
+	size, err := r.getRepoSize(history)
+	if err != nil {
+		return RepoSize{}, fmt.Errorf("GetRepoSize: %w", err)
+	}
+
+	return size, nil
+}
@@ -0,0 +1,116 @@
+package reporeader_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/djyuhn/gitcha/gittest"
+	"github.com/djyuhn/gitcha/internal/reporeader"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoReader_GetRepoSize(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+	authorOne := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: start}
+	authorTwo := object.Signature{Name: "Gitcha Two", Email: "gitcha2@gitcha.com", When: start.Add(time.Hour)}
+	authorThree := object.Signature{Name: "Gitcha Three", Email: "gitcha3@gitcha.com", When: start.Add(2 * time.Hour)}
+
+	video := "\x00" + strings.Repeat("v", 4999)
+	logo := "\x89PNG\x00" + strings.Repeat("p", 1995)
+	readme := "# gitcha\n"
+
+	commits := []gittest.LocalCommit{
+		{Author: authorOne, Message: "Initial commit", Files: map[string]string{"README.md": readme}},
+		{Author: authorTwo, Message: "Add the demo video", Files: map[string]string{"assets/video.mp4": video}},
+		{Author: authorTwo, Message: "Add the logo", Files: map[string]string{"assets/logo.png": logo}},
+		{Author: authorThree, Message: "Remove the demo video", Removed: []string{"assets/video.mp4"}},
+		{Author: authorThree, Message: "Move the logo", Files: map[string]string{"docs/logo.png": logo}, Removed: []string{"assets/logo.png"}},
+	}
+
+	t.Run("given a repository should return the largest blobs and where they were introduced", func(t *testing.T) {
+		t.Parallel()
+
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+		hashes := commitHashesByMessage(t, repo)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetRepoSize()
+		require.NoError(t, err)
+
+		require.Len(t, actual.LargestBlobs, 3)
+		actual.LargestBlobs[0].Date = actual.LargestBlobs[0].Date.UTC()
+		assert.Equal(t, reporeader.LargeBlob{
+			Hash:   actual.LargestBlobs[0].Hash,
+			Size:   int64(len(video)),
+			Path:   "assets/video.mp4",
+			Commit: hashes["Add the demo video"].String(),
+			Author: reporeader.Author{Name: authorTwo.Name, Email: authorTwo.Email},
+			Date:   authorTwo.When,
+			AtHead: false,
+			Binary: true,
+		}, actual.LargestBlobs[0])
+
+		logoBlob := actual.LargestBlobs[1]
+		assert.Equal(t, "assets/logo.png", logoBlob.Path)
+		assert.Equal(t, hashes["Add the logo"].String(), logoBlob.Commit)
+		assert.True(t, logoBlob.AtHead)
+		assert.True(t, logoBlob.Binary)
+
+		assert.Equal(t, "README.md", actual.LargestBlobs[2].Path)
+		assert.False(t, actual.LargestBlobs[2].Binary)
+
+		assert.Equal(t, reporeader.ObjectStats{Count: 1, Size: int64(len(video))}, actual.HistoryBlobs)
+		assert.Equal(t, reporeader.ObjectStats{Count: 2, Size: int64(len(logo) + len(readme))}, actual.HeadBlobs)
+		assert.Equal(t, 5, actual.Objects["commit"].Count)
+		assert.Equal(t, 3, actual.Objects["blob"].Count)
+		assert.Equal(t, int64(len(video)+len(logo)+len(readme)), actual.Objects["blob"].Size)
+		assert.Positive(t, actual.DiskSize())
+		assert.Equal(t, actual.DiskSize(), actual.Loose.Size+actual.Packs.Size)
+	})
+
+	t.Run("given a large blob count should limit the largest blobs", func(t *testing.T) {
+		t.Parallel()
+
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo, reporeader.WithLargeBlobCount(1))
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetRepoSize()
+		require.NoError(t, err)
+
+		require.Len(t, actual.LargestBlobs, 1)
+		assert.Equal(t, "assets/video.mp4", actual.LargestBlobs[0].Path)
+		assert.Equal(t, 1, actual.HistoryBlobs.Count)
+		assert.Equal(t, 2, actual.HeadBlobs.Count)
+	})
+	t.Run("given zero or negative large blob count should report no blobs but still count them", func(t *testing.T) {
+		t.Parallel()
+
+		for _, count := range []int{0, -1} {
+			_, repo, err := gittest.CreateLocalRepo(t, commits)
+			require.NoError(t, err)
+
+			repoReader, err := reporeader.NewRepoReaderRepository(repo, reporeader.WithLargeBlobCount(count))
+			require.NoError(t, err)
+
+			actual, err := repoReader.GetRepoSize()
+			require.NoError(t, err)
+
+			assert.NotNil(t, actual.LargestBlobs, "count %d", count)
+			assert.Empty(t, actual.LargestBlobs, "count %d", count)
+			assert.Equal(t, 1, actual.HistoryBlobs.Count, "count %d", count)
+			assert.Equal(t, 2, actual.HeadBlobs.Count, "count %d", count)
+		}
+	})
+}
@@ -0,0 +1,110 @@
+package reporeader
+
+import (
+	"fmt"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// Status is the state of the checkout: the files with changes in the worktree or the staging area, the checked out
+// branch or detached HEAD and the upstream branch the checked out branch tracks.
+//
+// A file can be counted as both staged and modified when it has changes that are staged and changes that are not.
+type Status struct {
+	Branch     string `json:"branch"`
+	Detached   bool   `json:"detached"`
+	Head       string `json:"head"`
+	Upstream   string `json:"upstream"`
+	Staged     int    `json:"staged"`
+	Modified   int    `json:"modified"`
+	Untracked  int    `json:"untracked"`
+	Conflicted int    `json:"conflicted"`
+}
+
+// Clean reports whether the checkout has no staged, modified, untracked or conflicted files.
+func (s Status) Clean() bool {
+	return s.Staged == 0 && s.Modified == 0 && s.Untracked == 0 && s.Conflicted == 0
+}
+
+// getStatus reads the state of the checkout from the worktree and the repository configuration.
+func (r *RepoReader) getStatus(wt *git.Worktree) (Status, error) {
+	status := Status{}
+
+	head, err := r.repository.Head()
+	if err != nil {
+		return Status{}, fmt.Errorf("getStatus: unable to get the repository head: %w", err)
+	}
+	status.Head = head.Hash().String()
+	if head.Name().IsBranch() {
+		status.Branch = head.Name().Short()
+	} else {
+		status.Detached = true
+	}
+
+	if status.Branch != "" {
+		upstream, err := r.getUpstream(status.Branch)
+		if err != nil {
+			return Status{}, fmt.Errorf("getStatus: %w", err)
+		}
+		status.Upstream = upstream
+	}
+
+	files, err := wt.Status()
+	if err != nil {
+		return Status{}, fmt.Errorf("getStatus: unable to get the worktree status: %w", err)
+	}
+
+	for _, file := range files {
+		switch {
+		case file.Staging == git.UpdatedButUnmerged || file.Worktree == git.UpdatedButUnmerged:
+			status.Conflicted++
+		case file.Worktree == git.Untracked:
+			status.Untracked++
+		default:
+			if file.Staging != git.Unmodified {
+				status.Staged++
+			}
+			if file.Worktree != git.Unmodified {
+				status.Modified++
+			}
+		}
+	}
+
+	return status, nil
+}
+
+// getUpstream returns the branch the given local branch tracks, such as origin/main, or an empty string when it does
+// not track one. A branch tracking another local branch returns the name of that branch.
+func (r *RepoReader) getUpstream(branch string) (string, error) {
+	cfg, err := r.repository.Config()
+	if err != nil {
+		return "", fmt.Errorf("getUpstream: unable to read the repository config: %w", err)
+	}
+
+	branchCfg, ok := cfg.Branches[branch]
+	if !ok || branchCfg.Merge == "" {
+		return "", nil
+	}
+
+	if branchCfg.Remote == "" || branchCfg.Remote == "." {
+		return branchCfg.Merge.Short(), nil
+	}
+
+	return plumbing.NewRemoteReferenceName(branchCfg.Remote, branchCfg.Merge.Short()).Short(), nil
+}
+
+// GetStatus returns the state of the checkout.
+func (r *RepoReader) GetStatus() (Status, error) {
+	wt, err := r.repository.Worktree()
+	if err != nil {
+		return Status{}, fmt.Errorf("GetStatus: unable to get the worktree from the repository: %w", err)
+	}
+
+	status, err := r.getStatus(wt)
+	if err != nil {
+		return Status{}, fmt.Errorf("GetStatus: %w", err)
+	}
+
+	return status, nil
+}
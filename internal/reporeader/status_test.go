@@ -0,0 +1,107 @@
+package reporeader_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/djyuhn/gitcha/gittest"
+	"github.com/djyuhn/gitcha/internal/reporeader"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoReader_GetStatus(t *testing.T) {
+	t.Parallel()
+
+	author := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)}
+	commits := []gittest.LocalCommit{
+		{Author: author, Message: "First", Files: map[string]string{"a.txt": "a\n", "b.txt": "b\n"}},
+		{Author: author, Message: "Second", Files: map[string]string{"c.txt": "c\n"}},
+	}
+
+	t.Run("given clean checkout should return branch and clean status", func(t *testing.T) {
+		t.Parallel()
+
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetStatus()
+		require.NoError(t, err)
+
+		head, err := repo.Head()
+		require.NoError(t, err)
+
+		assert.Equal(t, reporeader.Status{Branch: "master", Head: head.Hash().String()}, actual)
+		assert.True(t, actual.Clean())
+	})
+
+	t.Run("given worktree changes and upstream should return counts and tracking branch", func(t *testing.T) {
+		t.Parallel()
+
+		dir, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		cfg, err := repo.Config()
+		require.NoError(t, err)
+		cfg.Branches["master"] = &config.Branch{Name: "master", Remote: "origin", Merge: plumbing.Master}
+		require.NoError(t, repo.SetConfig(cfg))
+
+		wt, err := repo.Worktree()
+		require.NoError(t, err)
+
+		// a.txt is staged and then modified again, b.txt is modified and new.txt is untracked.
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a\nstaged\n"), 0o600))
+		_, err = wt.Add("a.txt")
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a\nstaged\nmodified\n"), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "b.txt"), []byte("b\nmodified\n"), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "new.txt"), []byte("new\n"), 0o600))
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetStatus()
+		require.NoError(t, err)
+
+		assert.Equal(t, "master", actual.Branch)
+		assert.Equal(t, "origin/master", actual.Upstream)
+		assert.Equal(t, 1, actual.Staged)
+		assert.Equal(t, 2, actual.Modified)
+		assert.Equal(t, 1, actual.Untracked)
+		assert.Equal(t, 0, actual.Conflicted)
+		assert.False(t, actual.Clean())
+	})
+
+	t.Run("given detached HEAD should return detached status without upstream", func(t *testing.T) {
+		t.Parallel()
+
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		hashes := commitHashesByMessage(t, repo)
+		wt, err := repo.Worktree()
+		require.NoError(t, err)
+		require.NoError(t, wt.Checkout(&git.CheckoutOptions{Hash: hashes["First"]}))
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetStatus()
+		require.NoError(t, err)
+
+		assert.True(t, actual.Detached)
+		assert.Empty(t, actual.Branch)
+		assert.Empty(t, actual.Upstream)
+		assert.Equal(t, hashes["First"].String(), actual.Head)
+	})
+}
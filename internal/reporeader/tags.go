@@ -0,0 +1,211 @@
+package reporeader
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// Tag is a lightweight or annotated tag pointing at a commit.
+//
+// Date is the time the tag was created for annotated tags and the time the target commit was committed for
+// lightweight tags.
+type Tag struct {
+	Name      string    `json:"name"`
+	Hash      string    `json:"hash"`
+	Annotated bool      `json:"annotated"`
+	Date      time.Time `json:"date"`
+	Message   string    `json:"message,omitempty"`
+}
+
+// Release is a tag along with the changes it shipped since the previous release.
+//
+// Commits counts the non-merge commits reachable from the tag that are not reachable from any earlier tag and
+// Contributors holds the emails of the authors credited with them. SincePrevious is zero for the first release.
+type Release struct {
+	Tag           Tag           `json:"tag"`
+	Previous      string        `json:"previous,omitempty"`
+	SincePrevious time.Duration `json:"sincePrevious"`
+	Commits       int           `json:"commits"`
+	Contributors  []string      `json:"contributors"`
+}
+
+// Releases holds the releases of the repository ordered from the oldest to the newest along with the time between
+// consecutive releases.
+type Releases struct {
+	Releases        []Release     `json:"releases"`
+	AverageInterval time.Duration `json:"averageInterval"`
+	MedianInterval  time.Duration `json:"medianInterval"`
+}
+
+// getTags returns the tags of the repository pointing at commits ordered from the oldest to the newest, then by name.
+// Tags pointing at other objects are skipped.
+func (r *RepoReader) getTags() ([]Tag, error) {
+	refs, err := r.repository.Tags()
+	if err != nil {
+		return nil, fmt.Errorf("getTags: unable to list tags: %w", err)
+	}
+
+	tags := make([]Tag, 0)
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		tag := Tag{Name: ref.Name().Short()}
+
+		annotated, err := r.repository.TagObject(ref.Hash())
+		switch {
+		case err == nil:
+			commit, err := annotated.Commit()
+			if err != nil {
+				return nil
+			}
+			tag.Hash = commit.Hash.String()
+			tag.Annotated = true
+			tag.Date = annotated.Tagger.When
+			tag.Message = annotated.Message
+		case errors.Is(err, plumbing.ErrObjectNotFound):
+			commit, err := r.repository.CommitObject(ref.Hash())
+			if err != nil {
+				return nil
+			}
+			tag.Hash = commit.Hash.String()
+			tag.Date = commit.Committer.When
+		default:
+			return fmt.Errorf("unable to read tag %s: %w", tag.Name, err)
+		}
+
+		tags = append(tags, tag)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getTags: %w", err)
+	}
+
+	sort.Slice(tags, func(i, j int) bool {
+		if !tags[i].Date.Equal(tags[j].Date) {
+			return tags[i].Date.Before(tags[j].Date)
+		}
+		return tags[i].Name < tags[j].Name
+	})
+
+	return tags, nil
+}
+
+// getReleases attributes every commit to the oldest tag it is reachable from to compute what each release shipped.
+//
+// Tags are walked in chronological order and each walk stops at commits already attributed to an earlier tag, so the
+// history is only walked once however many tags there are.
+func (r *RepoReader) getReleases(tags []Tag) (Releases, error) {
+	releases := Releases{Releases: make([]Release, 0, len(tags))}
+
+	index, closer := r.getCommitNodeIndex()
+	if closer != nil {
+		defer closer.Close()
+	}
+
+	visited := make(map[plumbing.Hash]bool)
+	intervals := make([]time.Duration, 0, len(tags))
+
+	for i, tag := range tags {
+		release := Release{Tag: tag, Contributors: make([]string, 0)}
+		if i > 0 {
+			release.Previous = tags[i-1].Name
+			release.SincePrevious = tag.Date.Sub(tags[i-1].Date)
+			intervals = append(intervals, release.SincePrevious)
+		}
+
+		contributors := make(map[string]bool)
+		stack := []plumbing.Hash{plumbing.NewHash(tag.Hash)}
+		for len(stack) > 0 {
+			hash := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if visited[hash] {
+				continue
+			}
+			visited[hash] = true
+
+			node, err := index.Get(hash)
+			if err != nil {
+				return Releases{}, fmt.Errorf("getReleases: unable to find commit %s: %w", hash, err)
+			}
+			stack = append(stack, node.ParentHashes()...)
+
+			if node.NumParents() > 1 {
+				continue
+			}
+
+			commit, err := node.Commit()
+			if err != nil {
+				return Releases{}, fmt.Errorf("getReleases: unable to read commit %s: %w", hash, err)
+			}
+
+			credited := r.getCreditedEmails(r.newCommit(commit))
+			if len(credited) == 0 {
+				continue
+			}
+			release.Commits++
+			for _, email := range credited {
+				contributors[email] = true
+			}
+		}
+
+		for email := range contributors {
+			release.Contributors = append(release.Contributors, email)
+		}
+		sort.Strings(release.Contributors)
+
+		releases.Releases = append(releases.Releases, release)
+	}
+
+	releases.AverageInterval, releases.MedianInterval = averageAndMedian(intervals)
+
+	return releases, nil
+}
+
+// averageAndMedian returns the average and median of durations, or zeros when there are none. Durations are sorted
+// in place.
+func averageAndMedian(durations []time.Duration) (time.Duration, time.Duration) {
+	if len(durations) == 0 {
+		return 0, 0
+	}
+
+	total := time.Duration(0)
+	for _, duration := range durations {
+		total += duration
+	}
+
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+	middle := len(durations) / 2
+	median := durations[middle]
+	if len(durations)%2 == 0 {
+		median = (durations[middle-1] + durations[middle]) / 2
+	}
+
+	return total / time.Duration(len(durations)), median
+}
+
+// GetTags returns the lightweight and annotated tags of the repository ordered from the oldest to the newest.
+func (r *RepoReader) GetTags() ([]Tag, error) {
+	tags, err := r.getTags()
+	if err != nil {
+		return nil, fmt.Errorf("GetTags: %w", err)
+	}
+
+	return tags, nil
+}
+
+// GetReleases returns the releases of the repository, one per tag, with the commits and contributors each shipped.
+func (r *RepoReader) GetReleases() (Releases, error) {
+	tags, err := r.getTags()
+	if err != nil {
+		return Releases{}, fmt.Errorf("GetReleases: %w", err)
+	}
+
+	releases, err := r.getReleases(tags)
+	if err != nil {
+		return Releases{}, fmt.Errorf("GetReleases: %w", err)
+	}
+
+	return releases, nil
+}
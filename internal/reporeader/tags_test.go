@@ -0,0 +1,115 @@
+package reporeader_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/djyuhn/gitcha/gittest"
+	"github.com/djyuhn/gitcha/internal/reporeader"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoReader_GetReleases(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+	authorOne := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: start}
+	authorTwo := object.Signature{Name: "Gitcha Two", Email: "gitcha2@gitcha.com", When: start.Add(24 * time.Hour)}
+	authorThree := object.Signature{Name: "Gitcha Three", Email: "gitcha3@gitcha.com", When: start.Add(48 * time.Hour)}
+
+	t.Run("given lightweight and annotated tags should return releases with commits, contributors and cadence", func(t *testing.T) {
+		t.Parallel()
+
+		commits := []gittest.LocalCommit{
+			{Author: authorOne, Message: "First", Files: map[string]string{"a.txt": "a\n"}},
+			{Author: authorOne, Message: "Second", Files: map[string]string{"b.txt": "b\n"}},
+			{Author: authorTwo, Message: "Third", Files: map[string]string{"c.txt": "c\n"}},
+			{Author: authorThree, Message: "Fourth", Files: map[string]string{"d.txt": "d\n"}},
+			{
+				Author:  object.Signature{Name: authorThree.Name, Email: authorThree.Email, When: start.Add(96 * time.Hour)},
+				Message: "Fifth",
+				Files:   map[string]string{"e.txt": "e\n"},
+			},
+		}
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		hashes := commitHashesByMessage(t, repo)
+
+		// A lightweight tag is dated by its commit while an annotated tag is dated by its tagger.
+		_, err = repo.CreateTag("v0.1.0", hashes["Second"], nil)
+		require.NoError(t, err)
+		tagger := &object.Signature{Name: "Releaser", Email: "releaser@gitcha.com", When: start.Add(72 * time.Hour)}
+		_, err = repo.CreateTag("v0.2.0", hashes["Fourth"], &git.CreateTagOptions{Tagger: tagger, Message: "Release v0.2.0"})
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetReleases()
+		require.NoError(t, err)
+
+		require.Len(t, actual.Releases, 2)
+
+		first := actual.Releases[0]
+		assert.Equal(t, "v0.1.0", first.Tag.Name)
+		assert.False(t, first.Tag.Annotated)
+		assert.Equal(t, hashes["Second"].String(), first.Tag.Hash)
+		assert.True(t, start.Equal(first.Tag.Date))
+		assert.Empty(t, first.Previous)
+		assert.Equal(t, 2, first.Commits)
+		assert.Equal(t, []string{authorOne.Email}, first.Contributors)
+
+		second := actual.Releases[1]
+		assert.Equal(t, "v0.2.0", second.Tag.Name)
+		assert.True(t, second.Tag.Annotated)
+		assert.Equal(t, "Release v0.2.0\n", second.Tag.Message)
+		assert.Equal(t, hashes["Fourth"].String(), second.Tag.Hash)
+		assert.Equal(t, "v0.1.0", second.Previous)
+		assert.Equal(t, 72*time.Hour, second.SincePrevious)
+		assert.Equal(t, 2, second.Commits)
+		assert.Equal(t, []string{authorTwo.Email, authorThree.Email}, second.Contributors)
+
+		assert.Equal(t, 72*time.Hour, actual.AverageInterval)
+		assert.Equal(t, 72*time.Hour, actual.MedianInterval)
+	})
+
+	t.Run("given no tags should return no releases", func(t *testing.T) {
+		t.Parallel()
+
+		commits := []gittest.LocalCommit{{Author: authorOne, Message: "First", Files: map[string]string{"a.txt": "a\n"}}}
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetReleases()
+
+		assert.NoError(t, err)
+		assert.Empty(t, actual.Releases)
+		assert.Zero(t, actual.AverageInterval)
+	})
+}
+
+// commitHashesByMessage returns the hash of every commit reachable from HEAD keyed by its message.
+func commitHashesByMessage(t *testing.T, repo *git.Repository) map[string]plumbing.Hash {
+	t.Helper()
+
+	iter, err := repo.Log(&git.LogOptions{})
+	require.NoError(t, err)
+
+	hashes := make(map[string]plumbing.Hash)
+	err = iter.ForEach(func(c *object.Commit) error {
+		hashes[c.Message] = c.Hash
+		return nil
+	})
+	require.NoError(t, err)
+
+	return hashes
+}
@@ -0,0 +1,86 @@
+package reporeader
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+const secondsInMinute = 60
+
+// TimezoneDistribution holds commit counts keyed by the UTC offset, in seconds, the commits were authored in.
+type TimezoneDistribution map[int]int
+
+// Timezones holds the timezone distribution of the repository as a whole and of each author by email.
+type Timezones struct {
+	Repository TimezoneDistribution            `json:"repository"`
+	Authors    map[string]TimezoneDistribution `json:"authors"`
+}
+
+// Offsets returns the UTC offsets of the distribution ordered by the highest to the lowest commit count. Offsets with
+// the same count are ordered from west to east.
+func (d TimezoneDistribution) Offsets() []int {
+	offsets := make([]int, 0, len(d))
+	for offset := range d {
+		offsets = append(offsets, offset)
+	}
+
+	sort.Slice(offsets, func(i, j int) bool {
+		if d[offsets[i]] != d[offsets[j]] {
+			return d[offsets[i]] > d[offsets[j]]
+		}
+		return offsets[i] < offsets[j]
+	})
+
+	return offsets
+}
+
+// FormatUTCOffset formats an offset in seconds east of UTC as UTC±hh:mm.
+func FormatUTCOffset(offset int) string {
+	sign := '+'
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+
+	minutes := offset / secondsInMinute
+
+	return fmt.Sprintf("UTC%c%02d:%02d", sign, minutes/secondsInMinute, minutes%secondsInMinute)
+}
+
+func (r *RepoReader) getTimezones(commits []*object.Commit) Timezones {
+	timezones := Timezones{
+		Repository: make(TimezoneDistribution),
+		Authors:    make(map[string]TimezoneDistribution),
+	}
+
+	for _, commit := range commits {
+		if r.excludesBot(commit.Author.Name, commit.Author.Email) {
+			continue
+		}
+
+		_, offset := commit.Author.When.Zone()
+
+		timezones.Repository[offset]++
+
+		email := commit.Author.Email
+		if _, ok := timezones.Authors[email]; !ok {
+			timezones.Authors[email] = make(TimezoneDistribution)
+		}
+		timezones.Authors[email][offset]++
+	}
+
+	return timezones
+}
+
+// GetTimezones returns the distribution of the UTC offsets commits were authored in for the repository and by author
+// email.
+func (r *RepoReader) GetTimezones() (Timezones, error) {
+	commits, err := r.getCommits()
+	if err != nil {
+		return Timezones{}, fmt.Errorf("GetTimezones: unable to get the repository commits: %w", err)
+	}
+
+	return r.getTimezones(commits), nil
+}
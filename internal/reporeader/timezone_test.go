@@ -0,0 +1,98 @@
+package reporeader_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/djyuhn/gitcha/gittest"
+	"github.com/djyuhn/gitcha/internal/reporeader"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoReader_GetTimezones(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given commits in different timezones should return distribution by repository and author", func(t *testing.T) {
+		t.Parallel()
+
+		const tokyoOffset = 9 * 60 * 60
+		const berlinOffset = 1 * 60 * 60
+		tokyo := time.FixedZone("", tokyoOffset)
+		berlin := time.FixedZone("", berlinOffset)
+		start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+
+		commits := []gittest.LocalCommit{
+			{
+				Author:  object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: start.In(tokyo)},
+				Message: "First",
+				Files:   map[string]string{"a.txt": "a\n"},
+			},
+			{
+				Author:  object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: start.Add(time.Hour).In(berlin)},
+				Message: "Second",
+				Files:   map[string]string{"b.txt": "b\n"},
+			},
+			{
+				Author:  object.Signature{Name: "Gitcha Two", Email: "gitcha2@gitcha.com", When: start.Add(2 * time.Hour).In(tokyo)},
+				Message: "Third",
+				Files:   map[string]string{"c.txt": "c\n"},
+			},
+		}
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		expected := reporeader.Timezones{
+			Repository: reporeader.TimezoneDistribution{tokyoOffset: 2, berlinOffset: 1},
+			Authors: map[string]reporeader.TimezoneDistribution{
+				"gitcha1@gitcha.com": {tokyoOffset: 1, berlinOffset: 1},
+				"gitcha2@gitcha.com": {tokyoOffset: 1},
+			},
+		}
+
+		actual, err := repoReader.GetTimezones()
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestTimezoneDistribution_Offsets(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given distribution should return offsets by highest count then west to east", func(t *testing.T) {
+		t.Parallel()
+
+		distribution := reporeader.TimezoneDistribution{3600: 1, -18000: 1, 32400: 4}
+
+		assert.Equal(t, []int{32400, -18000, 3600}, distribution.Offsets())
+	})
+}
+
+func TestFormatUTCOffset(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		offset   int
+		expected string
+	}{
+		"given zero offset should return UTC+00:00":           {offset: 0, expected: "UTC+00:00"},
+		"given positive offset should return plus sign":       {offset: 9 * 60 * 60, expected: "UTC+09:00"},
+		"given negative offset should return minus sign":      {offset: -5 * 60 * 60, expected: "UTC-05:00"},
+		"given offset with minutes should return the minutes": {offset: 5*60*60 + 30*60, expected: "UTC+05:30"},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, reporeader.FormatUTCOffset(test.offset))
+		})
+	}
+}
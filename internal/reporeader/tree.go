@@ -0,0 +1,195 @@
+package reporeader
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// TreeNode holds the aggregated stats of a file, or of every file beneath a directory, of the analyzed revision.
+//
+// Lines are the surviving lines of text files, Commits the number of distinct non-merge commits that changed the
+// files and Contributors the owners of the surviving lines, ordered from the author owning the most lines to the
+// least. Children are ordered with directories first, then by name.
+type TreeNode struct {
+	Name         string            `json:"name"`
+	Path         string            `json:"path"`
+	IsDir        bool              `json:"isDir"`
+	Files        int               `json:"files"`
+	Lines        int               `json:"lines"`
+	Commits      int               `json:"commits"`
+	Contributors []AuthorOwnership `json:"contributors"`
+	LastChange   time.Time         `json:"lastChange"`
+	Children     []TreeNode        `json:"children,omitempty"`
+}
+
+// treeAggregate accumulates the stats of the files beneath a directory.
+type treeAggregate struct {
+	commits    map[string]bool
+	authorship FileAuthorship
+}
+
+// getTree walks the tree of the newest commit and aggregates the authorship and history of each file into every
+// directory above it.
+func (r *RepoReader) getTree(history *commitHistory, authorship map[string]FileAuthorship, histories []FileHistory) (TreeNode, error) {
+	root := TreeNode{IsDir: true, Contributors: make([]AuthorOwnership, 0)}
+	if len(history.nodes) == 0 {
+		return root, nil
+	}
+
+	tree, err := history.nodes[0].Tree()
+	if err != nil {
+		return TreeNode{}, fmt.Errorf("getTree: unable to read tree of commit %s: %w", history.nodes[0].ID(), err)
+	}
+
+	historyByPath := make(map[string]FileHistory, len(histories))
+	for _, history := range histories {
+		historyByPath[history.Path] = history
+	}
+
+	root, _, err = buildTreeNode(tree, "", authorship, historyByPath)
+	if err != nil {
+		return TreeNode{}, fmt.Errorf("getTree: %w", err)
+	}
+
+	return root, nil
+}
+
+// buildTreeNode returns the node of the directory at dirPath whose content is tree, along with the aggregate of its
+// files so the parent directory can include them.
+func buildTreeNode(tree *object.Tree, dirPath string, authorship map[string]FileAuthorship, historyByPath map[string]FileHistory) (TreeNode, treeAggregate, error) {
+	node := TreeNode{Path: dirPath, IsDir: true, Children: make([]TreeNode, 0)}
+	if dirPath != "" {
+		node.Name = path.Base(dirPath)
+	}
+	aggregate := treeAggregate{commits: make(map[string]bool), authorship: make(FileAuthorship)}
+
+	for _, entry := range tree.Entries {
+		entryPath := path.Join(dirPath, entry.Name)
+
+		switch entry.Mode {
+		case filemode.Dir:
+			subtree, err := tree.Tree(entry.Name)
+			if err != nil {
+				return TreeNode{}, treeAggregate{}, fmt.Errorf("unable to read tree %s: %w", entryPath, err)
+			}
+
+			child, childAggregate, err := buildTreeNode(subtree, entryPath, authorship, historyByPath)
+			if err != nil {
+				return TreeNode{}, treeAggregate{}, err
+			}
+			node.Children = append(node.Children, child)
+			aggregate.add(childAggregate)
+			node.Files += child.Files
+			if child.LastChange.After(node.LastChange) {
+				node.LastChange = child.LastChange
+			}
+		case filemode.Submodule, filemode.Empty:
+			continue
+		case filemode.Regular, filemode.Deprecated, filemode.Executable, filemode.Symlink:
+			child, childAggregate := buildFileNode(entryPath, authorship[entryPath], historyByPath[entryPath])
+			node.Children = append(node.Children, child)
+			aggregate.add(childAggregate)
+			node.Files++
+			if child.LastChange.After(node.LastChange) {
+				node.LastChange = child.LastChange
+			}
+		}
+	}
+
+	ownership := newPathOwnership(dirPath, aggregate.authorship)
+	node.Lines = ownership.Lines
+	node.Contributors = ownership.Owners
+	node.Commits = len(aggregate.commits)
+
+	sort.SliceStable(node.Children, func(i, j int) bool {
+		if node.Children[i].IsDir != node.Children[j].IsDir {
+			return node.Children[i].IsDir
+		}
+		return node.Children[i].Name < node.Children[j].Name
+	})
+
+	return node, aggregate, nil
+}
+
+// buildFileNode returns the node of the file at filePath along with its aggregate.
+func buildFileNode(filePath string, fileAuthorship FileAuthorship, history FileHistory) (TreeNode, treeAggregate) {
+	aggregate := treeAggregate{commits: make(map[string]bool), authorship: fileAuthorship}
+	for _, change := range history.Changes {
+		aggregate.commits[change.Hash] = true
+	}
+
+	ownership := newPathOwnership(filePath, fileAuthorship)
+	stats := history.Stats(time.Time{})
+
+	node := TreeNode{
+		Name:         path.Base(filePath),
+		Path:         filePath,
+		Files:        1,
+		Lines:        ownership.Lines,
+		Commits:      len(aggregate.commits),
+		Contributors: ownership.Owners,
+		LastChange:   stats.LastModified,
+	}
+
+	return node, aggregate
+}
+
+// add merges other into the aggregate.
+func (a treeAggregate) add(other treeAggregate) {
+	for hash := range other.commits {
+		a.commits[hash] = true
+	}
+	for author, lines := range other.authorship {
+		a.authorship[author] += lines
+	}
+}
+
+// Find returns the node at nodePath beneath the node, or the node itself for an empty path.
+func (n TreeNode) Find(nodePath string) (TreeNode, bool) {
+	if nodePath == "" || nodePath == n.Path {
+		return n, true
+	}
+
+	for _, child := range n.Children {
+		if child.Path == nodePath {
+			return child, true
+		}
+		if child.IsDir && strings.HasPrefix(nodePath, child.Path+"/") {
+			return child.Find(nodePath)
+		}
+	}
+
+	return TreeNode{}, false
+}
+
+// GetTree returns the tree of HEAD with the aggregated stats of every file and directory.
+func (r *RepoReader) GetTree() (TreeNode, error) {
+	history, err := r.getHistory()
+	if err != nil {
+		return TreeNode{}, fmt.Errorf("GetTree: unable to get the repository history: %w", err)
+	}
+	defer history.close()
+
+	authorship, err := r.getFileAuthorship(history)
+	if err != nil {
+		return TreeNode{}, fmt.Errorf("GetTree: unable to get the file authorship: %w", err)
+	}
+
+	histories, err := r.getFileHistories(history)
+	if err != nil {
+		return TreeNode{}, fmt.Errorf("GetTree: unable to get the file histories: %w", err)
+	}
+
+	tree, err := r.getTree(history, authorship, histories)
+	if err != nil {
+		return TreeNode{}, fmt.Errorf("GetTree: %w", err)
+	}
+
+	return tree, nil
+}
@@ -0,0 +1,120 @@
+package reporeader_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/djyuhn/gitcha/gittest"
+	"github.com/djyuhn/gitcha/internal/reporeader"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoReader_GetTree(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+	authorOne := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: start}
+	authorTwo := object.Signature{Name: "Gitcha Two", Email: "gitcha2@gitcha.com", When: start.Add(time.Hour)}
+
+	t.Run("given nested directories should aggregate file stats into every directory above them", func(t *testing.T) {
+		t.Parallel()
+
+		commits := []gittest.LocalCommit{
+			{
+				Author:  authorOne,
+				Message: "Add files",
+				Files: map[string]string{
+					"README.md":                   "readme\n",
+					"services/billing/invoice.go": "one\ntwo\nthree\n",
+					"services/main.go":            "main\n",
+				},
+			},
+			{
+				Author:  authorTwo,
+				Message: "Change invoice",
+				Files:   map[string]string{"services/billing/invoice.go": "one\ntwo\nthree\nfour\n"},
+			},
+		}
+		_, repo, err := gittest.CreateLocalRepo(t, commits)
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+
+		actual, err := repoReader.GetTree()
+		require.NoError(t, err)
+
+		assert.True(t, actual.IsDir)
+		assert.Equal(t, 3, actual.Files)
+		assert.Equal(t, 6, actual.Lines)
+		assert.Equal(t, 2, actual.Commits)
+		require.Len(t, actual.Children, 2)
+
+		services := actual.Children[0]
+		assert.Equal(t, "services", services.Path)
+		assert.True(t, services.IsDir)
+		assert.Equal(t, 2, services.Files)
+		assert.Equal(t, 5, services.Lines)
+		assert.Equal(t, 2, services.Commits)
+		assert.True(t, authorTwo.When.Equal(services.LastChange))
+		assert.Equal(t, []reporeader.AuthorOwnership{
+			{Email: authorOne.Email, Lines: 4, Percent: 80},
+			{Email: authorTwo.Email, Lines: 1, Percent: 20},
+		}, services.Contributors)
+
+		require.Len(t, services.Children, 2)
+		assert.Equal(t, "services/billing", services.Children[0].Path)
+		assert.Equal(t, "billing", services.Children[0].Name)
+		assert.Equal(t, "services/main.go", services.Children[1].Path)
+		assert.False(t, services.Children[1].IsDir)
+		assert.Equal(t, 1, services.Children[1].Commits)
+
+		assert.Equal(t, "README.md", actual.Children[1].Path)
+	})
+}
+
+func TestTreeNode_Find(t *testing.T) {
+	t.Parallel()
+
+	root := reporeader.TreeNode{
+		IsDir: true,
+		Children: []reporeader.TreeNode{
+			{
+				Name:     "services",
+				Path:     "services",
+				IsDir:    true,
+				Children: []reporeader.TreeNode{{Name: "main.go", Path: "services/main.go"}},
+			},
+			{Name: "services.go", Path: "services.go"},
+		},
+	}
+
+	t.Run("given nested path should return the node", func(t *testing.T) {
+		t.Parallel()
+
+		actual, ok := root.Find("services/main.go")
+
+		assert.True(t, ok)
+		assert.Equal(t, "main.go", actual.Name)
+	})
+
+	t.Run("given path sharing a prefix with a directory should return the node", func(t *testing.T) {
+		t.Parallel()
+
+		actual, ok := root.Find("services.go")
+
+		assert.True(t, ok)
+		assert.Equal(t, "services.go", actual.Path)
+	})
+
+	t.Run("given missing path should return false", func(t *testing.T) {
+		t.Parallel()
+
+		_, ok := root.Find("services/missing.go")
+
+		assert.False(t, ok)
+	})
+}
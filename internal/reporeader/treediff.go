@@ -0,0 +1,133 @@
+package reporeader
+
+import (
+	"fmt"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/utils/merkletrie"
+)
+
+// renameLimit is the maximum number of added or deleted files considered for similarity based rename and copy
+// detection in a single diff, the same limit git uses by default. Beyond it only exact renames are detected.
+const renameLimit = 1000
+
+// treeChange is a change to a file between two trees.
+//
+// When From and To have different names the change is a rename, or a copy when copied is set, in which case the
+// file at From still exists.
+type treeChange struct {
+	*object.Change
+	copied bool
+}
+
+// sourceName returns the name of the file the change originates from, or the name of the file for added files.
+func (c treeChange) sourceName() string {
+	if c.From.Name != "" {
+		return c.From.Name
+	}
+
+	return c.To.Name
+}
+
+// diffTrees returns the changes from one tree to another, detecting renames and copies with the similarity the
+// RepoReader is configured with. A nil from tree is treated as an empty tree.
+func (r *RepoReader) diffTrees(from, to *object.Tree) ([]treeChange, error) {
+	changes, err := object.DiffTree(from, to)
+	if err != nil {
+		return nil, fmt.Errorf("diffTrees: unable to diff trees: %w", err)
+	}
+
+	if r.similarity == 0 || from == nil {
+		return toTreeChanges(changes, false), nil
+	}
+
+	opts := &object.DiffTreeOptions{
+		DetectRenames:    true,
+		RenameScore:      r.similarity,
+		RenameLimit:      renameLimit,
+		OnlyExactRenames: r.similarity == maxSimilarity,
+	}
+
+	changes, err = object.DetectRenames(changes, opts)
+	if err != nil {
+		return nil, fmt.Errorf("diffTrees: unable to detect renames: %w", err)
+	}
+
+	if !r.detectCopies {
+		return toTreeChanges(changes, false), nil
+	}
+
+	treeChanges, err := detectCopies(changes, opts)
+	if err != nil {
+		return nil, fmt.Errorf("diffTrees: %w", err)
+	}
+
+	return treeChanges, nil
+}
+
+// detectCopies pairs added files with the original content of files modified by the same changes, like git diff -C.
+//
+// The original content of each modified file is offered to the rename detection as if it had been deleted, so any
+// added file matched with one of them is a copy.
+func detectCopies(changes object.Changes, opts *object.DiffTreeOptions) ([]treeChange, error) {
+	treeChanges := make([]treeChange, 0, len(changes))
+	candidates := make(object.Changes, 0)
+	sources := 0
+
+	for _, change := range changes {
+		action, err := change.Action()
+		if err != nil {
+			return nil, fmt.Errorf("detectCopies: %w", err)
+		}
+
+		switch action {
+		case merkletrie.Insert:
+			candidates = append(candidates, change)
+			continue
+		case merkletrie.Modify:
+			if change.From.Name == change.To.Name {
+				candidates = append(candidates, &object.Change{From: change.From})
+				sources++
+			}
+		case merkletrie.Delete:
+		}
+
+		treeChanges = append(treeChanges, treeChange{Change: change})
+	}
+
+	if sources == 0 || sources == len(candidates) {
+		return append(treeChanges, toTreeChanges(candidates[sources:], false)...), nil
+	}
+
+	detected, err := object.DetectRenames(candidates, opts)
+	if err != nil {
+		return nil, fmt.Errorf("detectCopies: unable to detect copies: %w", err)
+	}
+
+	for _, change := range detected {
+		action, err := change.Action()
+		if err != nil {
+			return nil, fmt.Errorf("detectCopies: %w", err)
+		}
+
+		switch action {
+		case merkletrie.Insert:
+			treeChanges = append(treeChanges, treeChange{Change: change})
+		case merkletrie.Modify:
+			treeChanges = append(treeChanges, treeChange{Change: change, copied: true})
+		case merkletrie.Delete:
+			// A modified file that was not copied, it is already part of the changes.
+		}
+	}
+
+	return treeChanges, nil
+}
+
+func toTreeChanges(changes object.Changes, copied bool) []treeChange {
+	treeChanges := make([]treeChange, 0, len(changes))
+	for _, change := range changes {
+		treeChanges = append(treeChanges, treeChange{Change: change, copied: copied})
+	}
+
+	return treeChanges
+}
@@ -0,0 +1,317 @@
+package secrets
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+)
+
+const (
+	// redactedLength is the number of leading characters of a secret kept when it is redacted.
+	redactedLength = 4
+	// redactedMask replaces the rest of a redacted secret.
+	redactedMask = "****"
+
+	// secretEntropy is the entropy a value assigned to a secret must reach, in bits per character, to be reported.
+	secretEntropy = 3.5
+	// stringEntropy is the entropy a string literal must reach to be reported on its own, above the 4 bits per
+	// character hexadecimal hashes are limited to.
+	stringEntropy = 4.5
+)
+
+// Rule identifies a kind of secret in the lines added to a file.
+//
+// The secret is the first capture group of Pattern when it has one and its whole match otherwise. When MinEntropy is
+// set, secrets whose Shannon entropy in bits per character is lower are ignored, which leaves out placeholders and
+// other repetitive values.
+type Rule struct {
+	ID          string
+	Description string
+	Pattern     *regexp.Regexp
+	MinEntropy  float64
+}
+
+// Match is a secret found in a line.
+type Match struct {
+	Rule   Rule
+	Secret string
+}
+
+// Config is the rule set of a Scanner, as read from a JSON config file.
+//
+// Rules are added to the default rules unless DisableDefaultRules is set, and replace the default rule with the same
+// ID. Files matching one of the Allowlist paths are not scanned and secrets matching one of its patterns are ignored.
+type Config struct {
+	DisableDefaultRules bool         `json:"disableDefaultRules"`
+	Rules               []RuleConfig `json:"rules"`
+	Allowlist           Allowlist    `json:"allowlist"`
+}
+
+// RuleConfig is a Rule as written in a config file, with its pattern as a regular expression.
+type RuleConfig struct {
+	ID          string  `json:"id"`
+	Description string  `json:"description"`
+	Pattern     string  `json:"pattern"`
+	MinEntropy  float64 `json:"minEntropy"`
+}
+
+// Allowlist lists what a Scanner leaves out. Paths are matched with the wildcards of path.Match against the full path
+// of a file and against its base name, so *.lock matches every lock file. Patterns are regular expressions matched
+// against secrets.
+type Allowlist struct {
+	Paths    []string `json:"paths"`
+	Patterns []string `json:"patterns"`
+}
+
+// DefaultRules are the rules for common credentials: cloud provider and service tokens, private keys, and values of
+// high entropy assigned to names suggesting a secret.
+var DefaultRules = []Rule{
+	{
+		ID:          "aws-access-key-id",
+		Description: "AWS access key ID",
+		Pattern:     regexp.MustCompile(`\b((?:AKIA|ASIA|ABIA|ACCA)[0-9A-Z]{16})\b`),
+	},
+	{
+		ID:          "aws-secret-access-key",
+		Description: "AWS secret access key",
+		Pattern:     regexp.MustCompile(`(?i)aws.{0,20}?(?:secret|key).{0,20}?[\s'"=:]+([A-Za-z0-9/+=]{40})\b`),
+		MinEntropy:  secretEntropy,
+	},
+	{
+		ID:          "private-key",
+		Description: "Private key",
+		Pattern:     regexp.MustCompile(`-----BEGIN (?:[A-Z0-9]+ )*PRIVATE KEY(?: BLOCK)?-----`),
+	},
+	{
+		ID:          "github-token",
+		Description: "GitHub token",
+		Pattern:     regexp.MustCompile(`\b(gh[pousr]_[A-Za-z0-9]{36,255}|github_pat_[A-Za-z0-9_]{82})\b`),
+	},
+	{
+		ID:          "gitlab-token",
+		Description: "GitLab personal access token",
+		Pattern:     regexp.MustCompile(`\b(glpat-[A-Za-z0-9_-]{20})\b`),
+	},
+	{
+		ID:          "slack-token",
+		Description: "Slack token",
+		Pattern:     regexp.MustCompile(`\b(xox[aboprs]-[A-Za-z0-9-]{10,72})\b`),
+	},
+	{
+		ID:          "stripe-key",
+		Description: "Stripe live key",
+		Pattern:     regexp.MustCompile(`\b((?:sk|rk)_live_[A-Za-z0-9]{24,99})\b`),
+	},
+	{
+		ID:          "google-api-key",
+		Description: "Google API key",
+		Pattern:     regexp.MustCompile(`\b(AIza[0-9A-Za-z_-]{35})\b`),
+	},
+	{
+		ID:          "generic-secret",
+		Description: "High entropy value assigned to a secret",
+		Pattern: regexp.MustCompile(
+			`(?i)(?:secret|token|passw(?:or)?d|api[_-]?key|credential)[A-Za-z0-9_.-]{0,20}['"]?\s*[:=]+\s*['"]?([A-Za-z0-9+/=_.-]{16,})`),
+		MinEntropy: secretEntropy,
+	},
+	{
+		ID:          "high-entropy-string",
+		Description: "High entropy string",
+		Pattern:     regexp.MustCompile(`['"]([A-Za-z0-9+/=_-]{32,})['"]`),
+		MinEntropy:  stringEntropy,
+	},
+}
+
+// DefaultAllowedPaths are the files left out of scans by default: lock and checksum files, which list hashes of high
+// entropy that are not secrets.
+var DefaultAllowedPaths = []string{"go.sum", "package-lock.json", "yarn.lock", "pnpm-lock.yaml", "Cargo.lock", "*.lock"}
+
+// Scanner finds secrets in the lines added to files.
+type Scanner struct {
+	rules          []Rule
+	allowedPaths   []string
+	allowedSecrets []*regexp.Regexp
+}
+
+// NewScanner creates a Scanner with the rules and allowlist of config.
+func NewScanner(config Config) (*Scanner, error) {
+	scanner := &Scanner{allowedPaths: append([]string{}, DefaultAllowedPaths...)}
+
+	rules := make([]Rule, 0, len(config.Rules))
+	byID := make(map[string]Rule, len(config.Rules))
+	for _, rule := range config.Rules {
+		if rule.ID == "" {
+			return nil, fmt.Errorf("NewScanner: rule with pattern %q has no id", rule.Pattern)
+		}
+		pattern, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("NewScanner: invalid pattern of rule %s: %w", rule.ID, err)
+		}
+		compiled := Rule{ID: rule.ID, Description: rule.Description, Pattern: pattern, MinEntropy: rule.MinEntropy}
+		rules = append(rules, compiled)
+		byID[rule.ID] = compiled
+	}
+
+	// A rule replacing a default rule takes its place so it keeps coming before the generic rules.
+	if !config.DisableDefaultRules {
+		for _, rule := range DefaultRules {
+			if replacement, ok := byID[rule.ID]; ok {
+				rule = replacement
+				delete(byID, rule.ID)
+			}
+			scanner.rules = append(scanner.rules, rule)
+		}
+	}
+	for _, rule := range rules {
+		if _, ok := byID[rule.ID]; ok {
+			scanner.rules = append(scanner.rules, rule)
+		}
+	}
+
+	for _, allowed := range config.Allowlist.Paths {
+		if _, err := path.Match(allowed, ""); err != nil {
+			return nil, fmt.Errorf("NewScanner: invalid allowed path %q: %w", allowed, err)
+		}
+		scanner.allowedPaths = append(scanner.allowedPaths, allowed)
+	}
+
+	for _, allowed := range config.Allowlist.Patterns {
+		pattern, err := regexp.Compile(allowed)
+		if err != nil {
+			return nil, fmt.Errorf("NewScanner: invalid allowed pattern %q: %w", allowed, err)
+		}
+		scanner.allowedSecrets = append(scanner.allowedSecrets, pattern)
+	}
+
+	return scanner, nil
+}
+
+// ParseConfig parses a JSON config file. Unknown fields are rejected so a misspelled setting is not silently ignored.
+func ParseConfig(data []byte) (Config, error) {
+	var config Config
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&config); err != nil {
+		return Config{}, fmt.Errorf("ParseConfig: invalid config: %w", err)
+	}
+
+	return config, nil
+}
+
+// ReadConfig reads the JSON config file at configPath.
+func ReadConfig(configPath string) (Config, error) {
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return Config{}, fmt.Errorf("ReadConfig: unable to read config %s: %w", configPath, err)
+	}
+
+	config, err := ParseConfig(data)
+	if err != nil {
+		return Config{}, fmt.Errorf("ReadConfig: %s: %w", configPath, err)
+	}
+
+	return config, nil
+}
+
+// Rules returns the rules of the scanner.
+func (s *Scanner) Rules() []Rule {
+	return s.rules
+}
+
+// Skips returns whether the file at filePath is left out of scans by the allowlist.
+func (s *Scanner) Skips(filePath string) bool {
+	base := path.Base(filePath)
+	for _, allowed := range s.allowedPaths {
+		if matched, _ := path.Match(allowed, filePath); matched {
+			return true
+		}
+		if matched, _ := path.Match(allowed, base); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Scan returns the secrets found in line, leaving out the secrets the allowlist permits. A secret overlapping one
+// already found by an earlier rule is only reported once, under the earlier rule, so specific rules come before
+// generic ones.
+func (s *Scanner) Scan(line string) []Match {
+	matches := make([]Match, 0)
+
+	for _, rule := range s.rules {
+		for _, match := range rule.Pattern.FindAllStringSubmatch(line, -1) {
+			secret := match[0]
+			if len(match) > 1 && match[1] != "" {
+				secret = match[1]
+			}
+			if rule.MinEntropy > 0 && Entropy(secret) < rule.MinEntropy {
+				continue
+			}
+			if s.allows(secret) || overlaps(matches, secret) {
+				continue
+			}
+			matches = append(matches, Match{Rule: rule, Secret: secret})
+		}
+	}
+
+	return matches
+}
+
+// overlaps returns whether secret contains or is contained in one of the secrets already matched.
+func overlaps(matches []Match, secret string) bool {
+	for _, match := range matches {
+		if strings.Contains(match.Secret, secret) || strings.Contains(secret, match.Secret) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Scanner) allows(secret string) bool {
+	for _, allowed := range s.allowedSecrets {
+		if allowed.MatchString(secret) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Entropy returns the Shannon entropy of text in bits per character.
+func Entropy(text string) float64 {
+	if text == "" {
+		return 0
+	}
+
+	counts := make(map[rune]int)
+	total := 0
+	for _, r := range text {
+		counts[r]++
+		total++
+	}
+
+	entropy := 0.0
+	for _, count := range counts {
+		p := float64(count) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+
+	return entropy
+}
+
+// Redact hides all but the first characters of secret so it can be reported without being leaked again.
+func Redact(secret string) string {
+	if len(secret) <= redactedLength {
+		return redactedMask
+	}
+
+	return strings.TrimSpace(secret[:redactedLength]) + redactedMask
+}
@@ -0,0 +1,165 @@
+package branches
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/djyuhn/gitcha/internal/reporeader"
+	"github.com/djyuhn/gitcha/internal/tui/style"
+)
+
+const (
+	branchColumnWidth = 36
+	dateColumnWidth   = 12
+	authorColumnWidth = 24
+	countColumnWidth  = 8
+	statusColumnWidth = 16
+	defaultViewHeight = 20
+
+	// headerHeight is the number of lines rendered above the table rows.
+	headerHeight = 3
+)
+
+// Branches is a cleanup list of the local and remote-tracking branches of the repository, least recently changed
+// first, with their divergence from the default branch and whether they are merged or stale.
+type Branches struct {
+	Branches reporeader.Branches
+
+	theme style.Theme
+	table table.Model
+	// rows are the branches in the order of the table rows.
+	rows []reporeader.Branch
+}
+
+var _ tea.Model = Branches{}
+
+func NewBranches(repoDetails reporeader.RepoDetails) Branches {
+	defaultTheme := style.NewDefaultTheme()
+
+	styles := table.DefaultStyles()
+	styles.Header = styles.Header.Foreground(defaultTheme.General.PrimaryColor)
+	styles.Selected = styles.Selected.Foreground(defaultTheme.General.SecondaryColor)
+
+	branches := make([]reporeader.Branch, len(repoDetails.Branches.Branches))
+	copy(branches, repoDetails.Branches.Branches)
+	sort.SliceStable(branches, func(i, j int) bool {
+		return branches[i].LastCommitDate.Before(branches[j].LastCommitDate)
+	})
+
+	rows := make([]table.Row, 0, len(branches))
+	for _, branch := range branches {
+		rows = append(rows, table.Row{
+			branch.Name,
+			branch.LastCommitDate.Format("2006-01-02"),
+			branch.LastAuthor.Name,
+			strconv.Itoa(branch.Ahead),
+			strconv.Itoa(branch.Behind),
+			formatStatus(branch),
+		})
+	}
+
+	t := table.New(
+		table.WithColumns([]table.Column{
+			{Title: "Branch", Width: branchColumnWidth},
+			{Title: "Last commit", Width: dateColumnWidth},
+			{Title: "Last author", Width: authorColumnWidth},
+			{Title: "Ahead", Width: countColumnWidth},
+			{Title: "Behind", Width: countColumnWidth},
+			{Title: "Status", Width: statusColumnWidth},
+		}),
+		table.WithRows(rows),
+		table.WithHeight(defaultViewHeight),
+		table.WithFocused(true),
+		table.WithStyles(styles),
+	)
+
+	return Branches{Branches: repoDetails.Branches, theme: *defaultTheme, table: t, rows: branches}
+}
+
+func (b Branches) Init() tea.Cmd {
+	return nil
+}
+
+func (b Branches) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(tea.WindowSizeMsg); ok {
+		b.SetHeight(msg.Height)
+		return b, nil
+	}
+
+	var cmd tea.Cmd
+	b.table, cmd = b.table.Update(msg)
+
+	return b, cmd
+}
+
+func (b Branches) View() string {
+	primaryColorStyle := lipgloss.NewStyle().Foreground(b.theme.General.PrimaryColor)
+	secondaryColorStyle := lipgloss.NewStyle().Foreground(b.theme.General.SecondaryColor)
+
+	label := primaryColorStyle.Render("Branches:")
+
+	branches := b.Branches.Branches
+	if len(branches) == 0 {
+		return fmt.Sprintf("%s %s", label, secondaryColorStyle.Render("no branches"))
+	}
+
+	merged, stale := 0, 0
+	for _, branch := range branches {
+		if branch.Merged {
+			merged++
+		}
+		if branch.Stale {
+			stale++
+		}
+	}
+
+	summary := fmt.Sprintf("%d compared with %s, %d merged, %d stale", len(branches), b.Branches.Default, merged, stale)
+
+	return fmt.Sprintf("%s %s\n\n%s", label, secondaryColorStyle.Render(summary), b.table.View())
+}
+
+// SetHeight sets the height available to the view, including its header.
+func (b *Branches) SetHeight(height int) {
+	if height > headerHeight {
+		b.table.SetHeight(height - headerHeight)
+	}
+}
+
+// SelectedBranch returns the name of the selected branch, if any.
+func (b Branches) SelectedBranch() (string, bool) {
+	branch, ok := b.Selected()
+	return branch.Name, ok
+}
+
+// Selected returns the selected branch, if any.
+func (b Branches) Selected() (reporeader.Branch, bool) {
+	cursor := b.table.Cursor()
+	if cursor < 0 || cursor >= len(b.rows) {
+		return reporeader.Branch{}, false
+	}
+
+	return b.rows[cursor], true
+}
+
+// formatStatus flags the default branch and the branches that are candidates for cleanup.
+func formatStatus(branch reporeader.Branch) string {
+	if branch.Default {
+		return "default"
+	}
+
+	status := make([]string, 0)
+	if branch.Merged {
+		status = append(status, "merged")
+	}
+	if branch.Stale {
+		status = append(status, "stale")
+	}
+
+	return strings.Join(status, ", ")
+}
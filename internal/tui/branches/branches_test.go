@@ -0,0 +1,117 @@
+package branches_test
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/djyuhn/gitcha/internal/reporeader"
+	"github.com/djyuhn/gitcha/internal/tui/branches"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBranches(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return branches model with the least recently changed branch selected", func(t *testing.T) {
+		t.Parallel()
+
+		repoDetails := reporeader.RepoDetails{Branches: repoBranches()}
+
+		actual := branches.NewBranches(repoDetails)
+
+		assert.Equal(t, repoDetails.Branches, actual.Branches)
+		name, ok := actual.SelectedBranch()
+		assert.True(t, ok)
+		assert.Equal(t, "old-feature", name)
+	})
+}
+
+func TestBranches_Init(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		model := branches.NewBranches(reporeader.RepoDetails{})
+
+		assert.Nil(t, model.Init())
+	})
+}
+
+func TestBranches_Update(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given down key should select the next least recently changed branch", func(t *testing.T) {
+		t.Parallel()
+
+		model := branches.NewBranches(reporeader.RepoDetails{Branches: repoBranches()})
+
+		updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+		actual, ok := updated.(branches.Branches)
+		require.True(t, ok)
+
+		name, ok := actual.SelectedBranch()
+		assert.True(t, ok)
+		assert.Equal(t, "origin/feature", name)
+	})
+}
+
+func TestBranches_View(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given branches should show summary and flag merged and stale branches", func(t *testing.T) {
+		t.Parallel()
+
+		model := branches.NewBranches(reporeader.RepoDetails{Branches: repoBranches()})
+
+		actual := model.View()
+
+		assert.Contains(t, actual, "3 compared with main, 1 merged, 1 stale")
+		assert.Contains(t, actual, "merged, stale")
+		assert.Contains(t, actual, "default")
+		assert.Contains(t, actual, "2022-06-01")
+	})
+
+	t.Run("given no branches should show placeholder", func(t *testing.T) {
+		t.Parallel()
+
+		model := branches.NewBranches(reporeader.RepoDetails{})
+
+		assert.Contains(t, model.View(), "no branches")
+	})
+}
+
+func repoBranches() reporeader.Branches {
+	return reporeader.Branches{
+		Default: "main",
+		Branches: []reporeader.Branch{
+			{
+				Name:           "main",
+				Default:        true,
+				LastCommitDate: time.Date(2023, time.January, 10, 0, 0, 0, 0, time.UTC),
+				LastAuthor:     reporeader.Author{Name: "Gitcha One"},
+			},
+			{
+				Name:           "old-feature",
+				LastCommitDate: time.Date(2022, time.June, 1, 0, 0, 0, 0, time.UTC),
+				LastAuthor:     reporeader.Author{Name: "Gitcha Two"},
+				Behind:         3,
+				Merged:         true,
+				Stale:          true,
+			},
+			{
+				Name:           "origin/feature",
+				Remote:         true,
+				LastCommitDate: time.Date(2023, time.January, 5, 0, 0, 0, 0, time.UTC),
+				LastAuthor:     reporeader.Author{Name: "Gitcha Three"},
+				Ahead:          2,
+				Behind:         1,
+			},
+		},
+	}
+}
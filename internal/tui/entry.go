@@ -2,14 +2,49 @@ package tui
 
 import (
 	"fmt"
+	"io"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 
+	"github.com/djyuhn/gitcha/internal/browser"
+	"github.com/djyuhn/gitcha/internal/clipboard"
+	"github.com/djyuhn/gitcha/internal/hosting"
 	"github.com/djyuhn/gitcha/internal/reporeader"
+	"github.com/djyuhn/gitcha/internal/tui/branches"
+	"github.com/djyuhn/gitcha/internal/tui/files"
 	"github.com/djyuhn/gitcha/internal/tui/overview"
+	"github.com/djyuhn/gitcha/internal/tui/releases"
+	"github.com/djyuhn/gitcha/internal/tui/style"
+	"github.com/djyuhn/gitcha/internal/tui/tree"
 )
 
+// View identifies one of the views of the TUI that can be switched between.
+type View int
+
+const (
+	OverviewView View = iota
+	FilesView
+	TreeView
+	ReleasesView
+	BranchesView
+)
+
+// viewNames are the tab labels of each View in order.
+var viewNames = []string{"Overview", "Files", "Tree", "Releases", "Branches"}
+
+// tabsHeight is the number of lines rendered for the tabs above the active view.
+const tabsHeight = 2
+
+// viewAnalyses are the deferred analyses each view shows the results of, loaded the first time the view is active.
+var viewAnalyses = map[View][]reporeader.Analysis{
+	OverviewView: {reporeader.AnalysisAuthorship, reporeader.AnalysisSize},
+	FilesView:    {reporeader.AnalysisHistories},
+	TreeView:     {reporeader.AnalysisAuthorship, reporeader.AnalysisHistories},
+}
+
 type EntryModel struct {
 	RepoReader  reporeader.RepoReader
 	RepoDetails reporeader.RepoDetails
@@ -17,8 +52,25 @@ type EntryModel struct {
 
 	Spinner  spinner.Model
 	Overview overview.Overview
+	Files    files.Files
+	Tree     tree.Tree
+	Releases releases.Releases
+	Branches branches.Branches
+
+	ActiveView View
+	Height     int
+
+	// Opener opens the web pages of the selection on the hosting provider of the repository.
+	Opener browser.Opener
+	// Clipboard copies the hash, path or author email of the selection to the clipboard of the terminal.
+	Clipboard clipboard.Clipboard
+	// Message is a short notice shown next to the tabs, such as the result of opening a web page.
+	Message string
 
 	IsLoading bool
+	// Analyzing is the deferred analysis being loaded, empty when none is. Analyses are loaded one at a time since
+	// the repository cannot be read concurrently.
+	Analyzing reporeader.Analysis
 }
 
 func NewEntryModel(repoReader *reporeader.RepoReader) (EntryModel, error) {
@@ -42,6 +94,25 @@ type LoadingRepoMsg struct {
 	IsLoading bool
 }
 
+// CopiedMsg reports the result of copying Text to the clipboard.
+type CopiedMsg struct {
+	Text string
+	Err  error
+}
+
+// AnalysisMsg reports the result of loading a deferred analysis, with RepoDetails holding its results.
+type AnalysisMsg struct {
+	Analysis    reporeader.Analysis
+	RepoDetails reporeader.RepoDetails
+	Err         error
+}
+
+// OpenedMsg reports the result of opening the web page at URL.
+type OpenedMsg struct {
+	URL string
+	Err error
+}
+
 func (m EntryModel) Init() tea.Cmd {
 	return tea.Batch(
 		m.Spinner.Tick,
@@ -52,11 +123,40 @@ func (m EntryModel) Init() tea.Cmd {
 func (m EntryModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		if msg.Type == tea.KeyCtrlC {
+		switch msg.Type {
+		case tea.KeyCtrlC:
 			return m, tea.Quit
+		case tea.KeyTab:
+			m.ActiveView = (m.ActiveView + 1) % View(len(viewNames))
+			m.Message = ""
+			return m.loadAnalysis()
+		case tea.KeyShiftTab:
+			m.ActiveView = (m.ActiveView + View(len(viewNames)) - 1) % View(len(viewNames))
+			m.Message = ""
+			return m.loadAnalysis()
 		}
+		switch msg.String() {
+		case "o":
+			return m.open(m.selectedURL)
+		case "a":
+			return m.open(m.selectedAuthorURL)
+		case "y":
+			return m.copy(m.selectedText())
+		case "Y":
+			return m.copy(m.selectedAuthorEmail())
+		default:
+			return m.updateActiveView(msg)
+		}
+	case tea.WindowSizeMsg:
+		m.Height = msg.Height
+		m.Overview.SetHeight(m.Height - tabsHeight)
+		m.Files.SetHeight(m.Height - tabsHeight)
+		m.Tree.SetHeight(m.Height - tabsHeight)
+		m.Releases.SetHeight(m.Height - tabsHeight)
+		m.Branches.SetHeight(m.Height - tabsHeight)
+		return m, nil
 	case spinner.TickMsg:
-		if m.IsLoading {
+		if m.IsLoading || m.Analyzing != "" {
 			var cmd tea.Cmd
 			m.Spinner, cmd = m.Spinner.Update(msg)
 			return m, cmd
@@ -66,15 +166,45 @@ func (m EntryModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.RepoDetails = msg.RepoDetails
 		m.RepoError = msg.Err
 		m.Overview = overview.NewOverview(msg.RepoDetails)
-		return m, createLoadingRepoCmd(false)
+		m.Files = files.NewFiles(msg.RepoDetails)
+		m.Tree = tree.NewTree(msg.RepoDetails)
+		m.Releases = releases.NewReleases(msg.RepoDetails)
+		m.Branches = branches.NewBranches(msg.RepoDetails)
+		if m.Height > 0 {
+			m.Overview.SetHeight(m.Height - tabsHeight)
+			m.Files.SetHeight(m.Height - tabsHeight)
+			m.Tree.SetHeight(m.Height - tabsHeight)
+			m.Releases.SetHeight(m.Height - tabsHeight)
+			m.Branches.SetHeight(m.Height - tabsHeight)
+		}
+		var cmd tea.Cmd
+		m, cmd = m.loadAnalysis()
+		if cmd == nil {
+			return m, createLoadingRepoCmd(false)
+		}
+		return m, tea.Batch(createLoadingRepoCmd(false), cmd)
+	case AnalysisMsg:
+		return m.updateAnalysis(msg)
 	case LoadingRepoMsg:
 		m.IsLoading = msg.IsLoading
 		return m, nil
+	case CopiedMsg:
+		if msg.Err != nil {
+			m.Message = fmt.Sprintf("Unable to copy %s: %v", msg.Text, msg.Err)
+			return m, nil
+		}
+		m.Message = fmt.Sprintf("Copied %s", msg.Text)
+		return m, nil
+	case OpenedMsg:
+		if msg.Err != nil {
+			m.Message = fmt.Sprintf("Unable to open %s: %v", msg.URL, msg.Err)
+			return m, nil
+		}
+		m.Message = fmt.Sprintf("Opened %s", msg.URL)
+		return m, nil
 	default:
 		return m, nil
 	}
-
-	return m, nil
 }
 
 func (m EntryModel) View() string {
@@ -85,7 +215,328 @@ func (m EntryModel) View() string {
 		return "An error occurred while processing the repository."
 	}
 
-	return m.Overview.View()
+	view := strings.Builder{}
+	view.WriteString(m.buildTabsView() + "\n")
+	view.WriteString(m.buildKeysView() + "\n")
+
+	switch m.ActiveView {
+	case OverviewView:
+		view.WriteString(m.Overview.View())
+	case FilesView:
+		view.WriteString(m.Files.View())
+	case TreeView:
+		view.WriteString(m.Tree.View())
+	case ReleasesView:
+		view.WriteString(m.Releases.View())
+	case BranchesView:
+		view.WriteString(m.Branches.View())
+	}
+
+	return view.String()
+}
+
+// updateActiveView forwards msg to the active view.
+func (m EntryModel) updateActiveView(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch m.ActiveView {
+	case OverviewView:
+		var updated tea.Model
+		updated, cmd = m.Overview.Update(msg)
+		if model, ok := updated.(overview.Overview); ok {
+			m.Overview = model
+		}
+	case FilesView:
+		var updated tea.Model
+		updated, cmd = m.Files.Update(msg)
+		if model, ok := updated.(files.Files); ok {
+			m.Files = model
+		}
+	case TreeView:
+		var updated tea.Model
+		updated, cmd = m.Tree.Update(msg)
+		if model, ok := updated.(tree.Tree); ok {
+			m.Tree = model
+		}
+	case ReleasesView:
+		var updated tea.Model
+		updated, cmd = m.Releases.Update(msg)
+		if model, ok := updated.(releases.Releases); ok {
+			m.Releases = model
+		}
+	case BranchesView:
+		var updated tea.Model
+		updated, cmd = m.Branches.Update(msg)
+		if model, ok := updated.(branches.Branches); ok {
+			m.Branches = model
+		}
+	}
+
+	return m, cmd
+}
+
+func (m EntryModel) buildTabsView() string {
+	theme := style.NewDefaultTheme()
+
+	activeStyle := lipgloss.NewStyle().Foreground(theme.General.PrimaryColor).Bold(true).Underline(true)
+	inactiveStyle := lipgloss.NewStyle().Foreground(theme.General.SecondaryColor)
+
+	tabs := make([]string, 0, len(viewNames))
+	for i, name := range viewNames {
+		if View(i) == m.ActiveView {
+			tabs = append(tabs, activeStyle.Render(name))
+			continue
+		}
+		tabs = append(tabs, inactiveStyle.Render(name))
+	}
+
+	view := strings.Join(tabs, " | ")
+	if m.Analyzing != "" {
+		view += "  " + m.Spinner.View() + inactiveStyle.Render(fmt.Sprintf(" Analyzing %s...", m.Analyzing))
+	}
+	if m.Message != "" {
+		view += "  " + inactiveStyle.Render(m.Message)
+	}
+
+	return view
+}
+
+// buildKeysView lists the keys acting on the selection of the active view, below the tabs.
+func (m EntryModel) buildKeysView() string {
+	theme := style.NewDefaultTheme()
+	helpStyle := lipgloss.NewStyle().Foreground(theme.General.SecondaryColor)
+
+	keys := make([]string, 0)
+	if _, ok := m.RepoDetails.Remotes.Project(); ok {
+		keys = append(keys, "o to open in the browser")
+		switch m.ActiveView {
+		case TreeView, BranchesView:
+			keys = append(keys, "a to open the author's commits")
+		case OverviewView, FilesView, ReleasesView:
+		}
+	}
+	switch m.ActiveView {
+	case FilesView, ReleasesView:
+		keys = append(keys, "y to copy")
+	case TreeView, BranchesView:
+		keys = append(keys, "y to copy", "Y to copy the author's email")
+	case OverviewView:
+	}
+
+	return helpStyle.Render(strings.Join(keys, ", "))
+}
+
+// loadAnalysis starts loading the first deferred analysis the active view shows, unless an analysis is already being
+// loaded.
+func (m EntryModel) loadAnalysis() (EntryModel, tea.Cmd) {
+	if m.Analyzing != "" {
+		return m, nil
+	}
+
+	for _, analysis := range viewAnalyses[m.ActiveView] {
+		if !m.RepoDetails.IsDeferred(analysis) {
+			continue
+		}
+
+		m.Analyzing = analysis
+		repoReader, details := m.RepoReader, m.RepoDetails
+		load := func() tea.Msg {
+			loaded, err := repoReader.LoadAnalysis(details, analysis)
+			return AnalysisMsg{Analysis: analysis, RepoDetails: loaded, Err: err}
+		}
+
+		return m, tea.Batch(load, m.Spinner.Tick)
+	}
+
+	return m, nil
+}
+
+// updateAnalysis shows the results of a loaded analysis in the views built from them, then loads the next analysis
+// the active view needs. A failing analysis is recorded in the errors of the details rather than loaded again.
+func (m EntryModel) updateAnalysis(msg AnalysisMsg) (tea.Model, tea.Cmd) {
+	m.Analyzing = ""
+
+	if msg.Err != nil {
+		details := m.RepoDetails
+		errs := make(map[string]string, len(details.Errors)+1)
+		for analysis, err := range details.Errors {
+			errs[analysis] = err
+		}
+		errs[string(msg.Analysis)] = msg.Err.Error()
+		details.Errors = errs
+
+		deferred := make([]reporeader.Analysis, 0, len(details.Deferred))
+		for _, analysis := range details.Deferred {
+			if analysis != msg.Analysis {
+				deferred = append(deferred, analysis)
+			}
+		}
+		details.Deferred = deferred
+
+		m.RepoDetails = details
+	} else {
+		m.RepoDetails = msg.RepoDetails
+	}
+
+	// The tree keeps its structure, so the expanded directories and selection are kept while its stats are updated,
+	// the files keep their hotspot window and the overview its scroll position.
+	m.Overview.SetRepoDetails(m.RepoDetails)
+	m.Files.SetFileHistories(m.RepoDetails.FileHistories)
+	m.Tree.Root = m.RepoDetails.Tree
+
+	return m.loadAnalysis()
+}
+
+// open opens the web page link builds for the selection of the active view on the project of the origin remote.
+func (m EntryModel) open(link func(project hosting.Project) string) (tea.Model, tea.Cmd) {
+	project, ok := m.RepoDetails.Remotes.Project()
+	if !ok {
+		m.Message = "No hosted remote to open"
+		return m, nil
+	}
+
+	url := link(project)
+	if url == "" {
+		m.Message = "No web page for the selection"
+		return m, nil
+	}
+
+	opener := m.Opener
+	return m, func() tea.Msg {
+		return OpenedMsg{URL: url, Err: opener.Open(url)}
+	}
+}
+
+// selectedURL returns the web page of the selection of the active view: the project, a file or directory at the
+// analyzed revision, a tag or the last commit of a branch.
+func (m EntryModel) selectedURL(project hosting.Project) string {
+	switch m.ActiveView {
+	case OverviewView:
+		return project.WebURL()
+	case FilesView:
+		if path, ok := m.Files.SelectedPath(); ok {
+			return project.FileURL(m.revision(), path)
+		}
+	case TreeView:
+		if node, ok := m.Tree.Selected(); ok {
+			if node.IsDir {
+				return project.TreeURL(m.revision(), node.Path)
+			}
+			return project.FileURL(m.revision(), node.Path)
+		}
+	case ReleasesView:
+		if tag, ok := m.Releases.SelectedTag(); ok {
+			return project.TagURL(tag)
+		}
+	case BranchesView:
+		if branch, ok := m.Branches.Selected(); ok {
+			return project.CommitURL(branch.Hash)
+		}
+	}
+
+	return ""
+}
+
+// selectedAuthorURL returns the commit search page of the author of the selection of the active view.
+func (m EntryModel) selectedAuthorURL(project hosting.Project) string {
+	email := m.selectedAuthorEmail()
+	if email == "" {
+		return ""
+	}
+
+	return project.AuthorURL(m.revision(), email)
+}
+
+// copy copies text to the clipboard of the terminal. The program is paused while the escape sequence is written so
+// that it is not interleaved with a frame of the renderer, and the sequence goes to the output of the program unless
+// the clipboard has its own.
+func (m EntryModel) copy(text string) (tea.Model, tea.Cmd) {
+	if text == "" {
+		m.Message = "Nothing to copy for the selection"
+		return m, nil
+	}
+
+	return m, tea.Exec(&copyCommand{clipboard: m.Clipboard, text: text}, func(err error) tea.Msg {
+		return CopiedMsg{Text: text, Err: err}
+	})
+}
+
+// copyCommand copies text to the clipboard of the terminal as a tea.ExecCommand, run while the program is paused.
+type copyCommand struct {
+	clipboard clipboard.Clipboard
+	text      string
+}
+
+// Run copies the text to the clipboard.
+func (c *copyCommand) Run() error {
+	return c.clipboard.Copy(c.text)
+}
+
+// SetStdin ignores the input of the program as copying reads nothing.
+func (c *copyCommand) SetStdin(io.Reader) {}
+
+// SetStdout sets the output of the clipboard to the terminal of the program, unless it has its own output. w is nil
+// when the program does not write to a terminal, leaving the clipboard to its default.
+func (c *copyCommand) SetStdout(w io.Writer) {
+	if c.clipboard.Output == nil && w != nil {
+		c.clipboard.Output = w
+	}
+}
+
+// SetStderr ignores the error output as copying reports its error to the callback.
+func (c *copyCommand) SetStderr(io.Writer) {}
+
+// selectedText returns what identifies the selection of the active view: the path of a file or directory or the hash
+// of the commit of a tag or branch.
+func (m EntryModel) selectedText() string {
+	switch m.ActiveView {
+	case FilesView:
+		if path, ok := m.Files.SelectedPath(); ok {
+			return path
+		}
+	case TreeView:
+		if path, ok := m.Tree.SelectedPath(); ok {
+			return path
+		}
+	case ReleasesView:
+		if release, ok := m.Releases.Selected(); ok {
+			return release.Tag.Hash
+		}
+	case BranchesView:
+		if branch, ok := m.Branches.Selected(); ok {
+			return branch.Hash
+		}
+	case OverviewView:
+	}
+
+	return ""
+}
+
+// selectedAuthorEmail returns the email of the author of the selection of the active view: the top contributor of a
+// file or directory or the last author of a branch.
+func (m EntryModel) selectedAuthorEmail() string {
+	switch m.ActiveView {
+	case TreeView:
+		if node, ok := m.Tree.Selected(); ok && len(node.Contributors) > 0 {
+			return node.Contributors[0].Email
+		}
+	case BranchesView:
+		if branch, ok := m.Branches.Selected(); ok {
+			return branch.LastAuthor.Email
+		}
+	case OverviewView, FilesView, ReleasesView:
+	}
+
+	return ""
+}
+
+// revision returns the analyzed revision as a commit hash so that links keep pointing at what was analyzed.
+func (m EntryModel) revision() string {
+	if m.RepoDetails.Status.Head != "" {
+		return m.RepoDetails.Status.Head
+	}
+
+	return "HEAD"
 }
 
 func (m EntryModel) processRepo() tea.Msg {
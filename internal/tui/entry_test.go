@@ -1,6 +1,7 @@
 package tui_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"strings"
@@ -11,10 +12,18 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/djyuhn/gitcha/gittest"
+	"github.com/djyuhn/gitcha/internal/browser"
+	"github.com/djyuhn/gitcha/internal/clipboard"
+	"github.com/djyuhn/gitcha/internal/hosting"
 	"github.com/djyuhn/gitcha/internal/reporeader"
 	"github.com/djyuhn/gitcha/internal/tui"
+	"github.com/djyuhn/gitcha/internal/tui/branches"
+	"github.com/djyuhn/gitcha/internal/tui/files"
 	"github.com/djyuhn/gitcha/internal/tui/overview"
+	"github.com/djyuhn/gitcha/internal/tui/releases"
+	"github.com/djyuhn/gitcha/internal/tui/tree"
 
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -328,6 +337,296 @@ func TestEntryModel_Update(t *testing.T) {
 		assert.Equal(t, loadingRepoMsg.IsLoading, actual.IsLoading)
 		assert.Nil(t, cmd)
 	})
+	t.Run("given tab key should switch to the next view", func(t *testing.T) {
+		t.Parallel()
+
+		model := tui.EntryModel{ActiveView: tui.OverviewView}
+
+		updatedModel, cmd := model.Update(tea.KeyMsg{Type: tea.KeyTab})
+
+		actual, ok := updatedModel.(tui.EntryModel)
+		require.True(t, ok)
+
+		assert.Equal(t, tui.FilesView, actual.ActiveView)
+		assert.Nil(t, cmd)
+	})
+
+	t.Run("given shift+tab key on the first view should switch to the last view", func(t *testing.T) {
+		t.Parallel()
+
+		model := tui.EntryModel{ActiveView: tui.OverviewView}
+
+		updatedModel, cmd := model.Update(tea.KeyMsg{Type: tea.KeyShiftTab})
+
+		actual, ok := updatedModel.(tui.EntryModel)
+		require.True(t, ok)
+
+		assert.Equal(t, tui.BranchesView, actual.ActiveView)
+		assert.Nil(t, cmd)
+	})
+
+	t.Run("given RepoDetailsMsg should update Files model", func(t *testing.T) {
+		t.Parallel()
+
+		repoDetails := reporeader.RepoDetails{
+			FileHistories: []reporeader.FileHistory{{Path: "main.go", Changes: []reporeader.FileChange{{Hash: "someHash", Added: 1}}}},
+		}
+
+		model := tui.EntryModel{}
+
+		expectedFiles := files.NewFiles(repoDetails)
+		updatedModel, _ := model.Update(tui.RepoDetailsMsg{RepoDetails: repoDetails})
+
+		actual, ok := updatedModel.(tui.EntryModel)
+		require.True(t, ok)
+
+		assert.Equal(t, expectedFiles, actual.Files)
+	})
+
+	t.Run("given key msg on files view should forward it to Files model", func(t *testing.T) {
+		t.Parallel()
+
+		model := tui.EntryModel{ActiveView: tui.FilesView, Files: files.NewFiles(reporeader.RepoDetails{})}
+
+		updatedModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")})
+
+		actual, ok := updatedModel.(tui.EntryModel)
+		require.True(t, ok)
+
+		assert.Equal(t, 1, actual.Files.Window)
+	})
+
+	t.Run("given RepoDetailsMsg should update Tree model", func(t *testing.T) {
+		t.Parallel()
+
+		repoDetails := reporeader.RepoDetails{Tree: treeNode()}
+
+		model := tui.EntryModel{}
+
+		updatedModel, _ := model.Update(tui.RepoDetailsMsg{RepoDetails: repoDetails})
+
+		actual, ok := updatedModel.(tui.EntryModel)
+		require.True(t, ok)
+
+		assert.Equal(t, repoDetails.Tree, actual.Tree.Root)
+	})
+
+	t.Run("given key msg on tree view should forward it to Tree model", func(t *testing.T) {
+		t.Parallel()
+
+		model := tui.EntryModel{ActiveView: tui.TreeView, Tree: tree.NewTree(reporeader.RepoDetails{Tree: treeNode()})}
+
+		updatedModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+		actual, ok := updatedModel.(tui.EntryModel)
+		require.True(t, ok)
+
+		assert.True(t, actual.Tree.Expanded["services"])
+	})
+
+	t.Run("given RepoDetailsMsg should update Releases model", func(t *testing.T) {
+		t.Parallel()
+
+		repoDetails := reporeader.RepoDetails{
+			Releases: reporeader.Releases{Releases: []reporeader.Release{{Tag: reporeader.Tag{Name: "v1.0.0"}}}},
+		}
+
+		model := tui.EntryModel{}
+
+		updatedModel, _ := model.Update(tui.RepoDetailsMsg{RepoDetails: repoDetails})
+
+		actual, ok := updatedModel.(tui.EntryModel)
+		require.True(t, ok)
+
+		assert.Equal(t, repoDetails.Releases, actual.Releases.Releases)
+	})
+
+	t.Run("given RepoDetailsMsg should update Branches model", func(t *testing.T) {
+		t.Parallel()
+
+		repoDetails := reporeader.RepoDetails{
+			Branches: reporeader.Branches{Default: "main", Branches: []reporeader.Branch{{Name: "main", Default: true}}},
+		}
+
+		model := tui.EntryModel{}
+
+		updatedModel, _ := model.Update(tui.RepoDetailsMsg{RepoDetails: repoDetails})
+
+		actual, ok := updatedModel.(tui.EntryModel)
+		require.True(t, ok)
+
+		assert.Equal(t, repoDetails.Branches, actual.Branches.Branches)
+	})
+
+	t.Run("given WindowSizeMsg should fit the overview below the tabs", func(t *testing.T) {
+		t.Parallel()
+
+		repoDetails := reporeader.RepoDetails{License: "MIT"}
+		const height = 6
+
+		model := tui.EntryModel{}
+		updatedModel, _ := model.Update(tui.RepoDetailsMsg{RepoDetails: repoDetails})
+		updatedModel, _ = updatedModel.Update(tea.WindowSizeMsg{Width: 80, Height: height})
+
+		actual, ok := updatedModel.(tui.EntryModel)
+		require.True(t, ok)
+
+		assert.Len(t, strings.Split(actual.View(), "\n"), height)
+	})
+}
+
+func TestEntryModel_LoadAnalysis(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given RepoDetailsMsg with analysis deferred for the overview should load it and show the result", func(t *testing.T) {
+		t.Parallel()
+
+		author := object.Signature{Name: "Gitcha One", Email: "gitcha1@gitcha.com", When: time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)}
+		_, repo, err := gittest.CreateLocalRepo(t, []gittest.LocalCommit{
+			{Author: author, Message: "Add readme", Files: map[string]string{"README.md": "# gitcha\n"}},
+		})
+		require.NoError(t, err)
+
+		repoReader, err := reporeader.NewRepoReaderRepository(repo)
+		require.NoError(t, err)
+		details, err := repoReader.GetRepoDetails()
+		require.NoError(t, err)
+
+		model, err := tui.NewEntryModel(repoReader)
+		require.NoError(t, err)
+
+		updatedModel, cmd := model.Update(tui.RepoDetailsMsg{RepoDetails: details})
+		loading, ok := updatedModel.(tui.EntryModel)
+		require.True(t, ok)
+		loading.IsLoading = false
+		assert.Equal(t, reporeader.AnalysisAuthorship, loading.Analyzing)
+		assert.Contains(t, loading.View(), "Analyzing authorship...")
+
+		analysisMsg, ok := findMsg[tui.AnalysisMsg](cmd)
+		require.True(t, ok)
+		require.NoError(t, analysisMsg.Err)
+
+		updatedModel, cmd = loading.Update(analysisMsg)
+		analyzed, ok := updatedModel.(tui.EntryModel)
+		require.True(t, ok)
+		assert.Equal(t, reporeader.AnalysisSize, analyzed.Analyzing)
+
+		analysisMsg, ok = findMsg[tui.AnalysisMsg](cmd)
+		require.True(t, ok)
+		require.NoError(t, analysisMsg.Err)
+
+		updatedModel, _ = analyzed.Update(analysisMsg)
+		actual, ok := updatedModel.(tui.EntryModel)
+		require.True(t, ok)
+
+		assert.Empty(t, actual.Analyzing)
+		assert.Equal(t, []reporeader.Analysis{reporeader.AnalysisHistories}, actual.RepoDetails.Deferred)
+		assert.Equal(t, 1, actual.RepoDetails.BusFactor.FileCount)
+		assert.Equal(t, 1, actual.RepoDetails.Size.Objects["commit"].Count)
+		assert.Contains(t, actual.Overview.View(), "Bus factor:")
+		assert.Equal(t, actual.RepoDetails.Tree, actual.Tree.Root)
+	})
+
+	t.Run("given tab to the tree view with deferred authorship should load it", func(t *testing.T) {
+		t.Parallel()
+
+		model := tui.EntryModel{
+			ActiveView:  tui.FilesView,
+			RepoDetails: reporeader.RepoDetails{Deferred: []reporeader.Analysis{reporeader.AnalysisAuthorship}},
+		}
+
+		updatedModel, cmd := model.Update(tea.KeyMsg{Type: tea.KeyTab})
+
+		actual, ok := updatedModel.(tui.EntryModel)
+		require.True(t, ok)
+
+		assert.Equal(t, tui.TreeView, actual.ActiveView)
+		assert.Equal(t, reporeader.AnalysisAuthorship, actual.Analyzing)
+		assert.NotNil(t, cmd)
+	})
+
+	t.Run("given AnalysisMsg should update the tree stats and keep its expanded directories and selection", func(t *testing.T) {
+		t.Parallel()
+
+		model := tui.EntryModel{ActiveView: tui.TreeView, Tree: tree.NewTree(reporeader.RepoDetails{Tree: treeNode()})}
+		model.Tree.Expanded["services"] = true
+		model.Tree.Cursor = 1
+
+		loaded := treeNode()
+		loaded.Lines = 42
+
+		updatedModel, _ := model.Update(tui.AnalysisMsg{
+			Analysis:    reporeader.AnalysisAuthorship,
+			RepoDetails: reporeader.RepoDetails{Tree: loaded},
+		})
+
+		actual, ok := updatedModel.(tui.EntryModel)
+		require.True(t, ok)
+
+		assert.Equal(t, loaded, actual.Tree.Root)
+		assert.True(t, actual.Tree.Expanded["services"])
+		assert.Equal(t, 1, actual.Tree.Cursor)
+	})
+
+	t.Run("given tab to a view without deferred analyses should not load any", func(t *testing.T) {
+		t.Parallel()
+
+		model := tui.EntryModel{
+			ActiveView:  tui.TreeView,
+			RepoDetails: reporeader.RepoDetails{Deferred: []reporeader.Analysis{reporeader.AnalysisAuthorship}},
+		}
+
+		updatedModel, cmd := model.Update(tea.KeyMsg{Type: tea.KeyTab})
+
+		actual, ok := updatedModel.(tui.EntryModel)
+		require.True(t, ok)
+
+		assert.Empty(t, actual.Analyzing)
+		assert.Nil(t, cmd)
+	})
+
+	t.Run("given failing AnalysisMsg should record the error and not load the analysis again", func(t *testing.T) {
+		t.Parallel()
+
+		model := tui.EntryModel{
+			RepoDetails: reporeader.RepoDetails{Deferred: []reporeader.Analysis{reporeader.AnalysisAuthorship}},
+			Analyzing:   reporeader.AnalysisAuthorship,
+		}
+
+		updatedModel, cmd := model.Update(tui.AnalysisMsg{
+			Analysis: reporeader.AnalysisAuthorship,
+			Err:      fmt.Errorf("LoadAnalysis: unable to get the file authorship"),
+		})
+
+		actual, ok := updatedModel.(tui.EntryModel)
+		require.True(t, ok)
+
+		assert.Empty(t, actual.Analyzing)
+		assert.Empty(t, actual.RepoDetails.Deferred)
+		assert.Equal(t, "LoadAnalysis: unable to get the file authorship", actual.RepoDetails.Errors["authorship"])
+		assert.Nil(t, cmd)
+	})
+}
+
+// findMsg runs cmd, and every command of a batch, returning the first message of type T.
+func findMsg[T tea.Msg](cmd tea.Cmd) (T, bool) {
+	var zero T
+	if cmd == nil {
+		return zero, false
+	}
+
+	switch msg := cmd().(type) {
+	case T:
+		return msg, true
+	case tea.BatchMsg:
+		for _, batched := range msg {
+			if found, ok := findMsg[T](batched); ok {
+				return found, true
+			}
+		}
+	}
+
+	return zero, false
 }
 
 func TestEntryModel_View(t *testing.T) {
@@ -388,4 +687,335 @@ func TestEntryModel_View(t *testing.T) {
 
 		assert.Contains(t, actual, model.Overview.View())
 	})
+
+	t.Run("given files view is active should return Files view", func(t *testing.T) {
+		t.Parallel()
+
+		repoDetails := reporeader.RepoDetails{
+			FileHistories: []reporeader.FileHistory{{Path: "main.go", Changes: []reporeader.FileChange{{Hash: "someHash", Added: 1}}}},
+		}
+		model := tui.EntryModel{
+			IsLoading:  false,
+			ActiveView: tui.FilesView,
+			Overview:   overview.NewOverview(repoDetails),
+			Files:      files.NewFiles(repoDetails),
+		}
+
+		actual := model.View()
+
+		assert.Contains(t, actual, model.Files.View())
+		assert.NotContains(t, actual, model.Overview.View())
+	})
+	t.Run("given tree view is active should return Tree view", func(t *testing.T) {
+		t.Parallel()
+
+		repoDetails := reporeader.RepoDetails{Tree: treeNode()}
+		model := tui.EntryModel{
+			IsLoading:  false,
+			ActiveView: tui.TreeView,
+			Tree:       tree.NewTree(repoDetails),
+		}
+
+		actual := model.View()
+
+		assert.Contains(t, actual, model.Tree.View())
+	})
+	t.Run("given releases view is active should return Releases view", func(t *testing.T) {
+		t.Parallel()
+
+		repoDetails := reporeader.RepoDetails{
+			Releases: reporeader.Releases{Releases: []reporeader.Release{{Tag: reporeader.Tag{Name: "v1.0.0"}}}},
+		}
+		model := tui.EntryModel{
+			IsLoading:  false,
+			ActiveView: tui.ReleasesView,
+			Releases:   releases.NewReleases(repoDetails),
+		}
+
+		actual := model.View()
+
+		assert.Contains(t, actual, model.Releases.View())
+	})
+	t.Run("given branches view is active should return Branches view", func(t *testing.T) {
+		t.Parallel()
+
+		repoDetails := reporeader.RepoDetails{
+			Branches: reporeader.Branches{Default: "main", Branches: []reporeader.Branch{{Name: "main", Default: true}}},
+		}
+		model := tui.EntryModel{
+			IsLoading:  false,
+			ActiveView: tui.BranchesView,
+			Branches:   branches.NewBranches(repoDetails),
+		}
+
+		actual := model.View()
+
+		assert.Contains(t, actual, model.Branches.View())
+	})
+}
+
+func TestEntryModel_Open(t *testing.T) {
+	t.Parallel()
+
+	head := "0123456789abcdef0123456789abcdef01234567"
+	project := hosting.Project{Provider: hosting.GitHub, Host: "github.com", Owner: "djyuhn", Name: "gitcha"}
+	repoDetails := reporeader.RepoDetails{
+		Status:  reporeader.Status{Branch: "main", Head: head},
+		Remotes: reporeader.Remotes{Remotes: []reporeader.Remote{{Name: "origin", Project: &project}}},
+		Tree:    treeNode(),
+		Branches: reporeader.Branches{Default: "main", Branches: []reporeader.Branch{
+			{Name: "feature", Hash: head, LastAuthor: reporeader.Author{Name: "Gitcha One", Email: "gitcha1@gitcha.com"}},
+		}},
+	}
+
+	loadedModel := func(t *testing.T, view tui.View) tui.EntryModel {
+		t.Helper()
+
+		updatedModel, _ := tui.EntryModel{Opener: browser.Opener{Command: []string{"true"}}}.Update(tui.RepoDetailsMsg{RepoDetails: repoDetails})
+		model, ok := updatedModel.(tui.EntryModel)
+		require.True(t, ok)
+		model.ActiveView = view
+
+		return model
+	}
+
+	tests := map[string]struct {
+		view     tui.View
+		key      string
+		expected string
+	}{
+		"given o key on overview should open the project": {
+			view: tui.OverviewView, key: "o", expected: "https://github.com/djyuhn/gitcha",
+		},
+		"given o key on tree should open the selected directory at the analyzed commit": {
+			view: tui.TreeView, key: "o", expected: "https://github.com/djyuhn/gitcha/tree/" + head + "/services",
+		},
+		"given o key on branches should open the last commit of the selected branch": {
+			view: tui.BranchesView, key: "o", expected: "https://github.com/djyuhn/gitcha/commit/" + head,
+		},
+		"given a key on branches should open the commits of the last author of the selected branch": {
+			view: tui.BranchesView, key: "a", expected: "https://github.com/djyuhn/gitcha/commits/" + head + "?author=gitcha1%40gitcha.com",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			model := loadedModel(t, test.view)
+
+			_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(test.key)})
+			require.NotNil(t, cmd)
+
+			assert.Equal(t, tui.OpenedMsg{URL: test.expected}, cmd())
+		})
+	}
+
+	t.Run("given a key without author for the selection should show notice", func(t *testing.T) {
+		t.Parallel()
+
+		model := loadedModel(t, tui.OverviewView)
+
+		updatedModel, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+
+		actual, ok := updatedModel.(tui.EntryModel)
+		require.True(t, ok)
+
+		assert.Nil(t, cmd)
+		assert.Equal(t, "No web page for the selection", actual.Message)
+	})
+
+	t.Run("given hosted remote should list the open keys of the active view", func(t *testing.T) {
+		t.Parallel()
+
+		overview := loadedModel(t, tui.OverviewView).View()
+		assert.Contains(t, overview, "o to open in the browser")
+		assert.NotContains(t, overview, "a to open the author's commits")
+
+		branches := loadedModel(t, tui.BranchesView).View()
+		assert.Contains(t, branches, "o to open in the browser, a to open the author's commits")
+	})
+
+	t.Run("given no hosted remote should not list the open keys", func(t *testing.T) {
+		t.Parallel()
+
+		updatedModel, _ := tui.EntryModel{}.Update(tui.RepoDetailsMsg{RepoDetails: reporeader.RepoDetails{}})
+		model, ok := updatedModel.(tui.EntryModel)
+		require.True(t, ok)
+
+		assert.NotContains(t, model.View(), "o to open")
+	})
+
+	t.Run("given no hosted remote should show notice", func(t *testing.T) {
+		t.Parallel()
+
+		model := tui.EntryModel{ActiveView: tui.OverviewView}
+
+		updatedModel, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o")})
+
+		actual, ok := updatedModel.(tui.EntryModel)
+		require.True(t, ok)
+
+		assert.Nil(t, cmd)
+		assert.Equal(t, "No hosted remote to open", actual.Message)
+	})
+
+	t.Run("given OpenedMsg should show the result next to the tabs", func(t *testing.T) {
+		t.Parallel()
+
+		model := tui.EntryModel{}
+
+		updatedModel, _ := model.Update(tui.OpenedMsg{URL: "https://github.com/djyuhn/gitcha"})
+		actual, ok := updatedModel.(tui.EntryModel)
+		require.True(t, ok)
+		assert.Equal(t, "Opened https://github.com/djyuhn/gitcha", actual.Message)
+		assert.Contains(t, actual.View(), "Opened https://github.com/djyuhn/gitcha")
+
+		updatedModel, _ = model.Update(tui.OpenedMsg{URL: "https://github.com/djyuhn/gitcha", Err: fmt.Errorf("missing")})
+		actual, ok = updatedModel.(tui.EntryModel)
+		require.True(t, ok)
+		assert.Equal(t, "Unable to open https://github.com/djyuhn/gitcha: missing", actual.Message)
+	})
+}
+
+func TestEntryModel_Copy(t *testing.T) {
+	t.Parallel()
+
+	head := "0123456789abcdef0123456789abcdef01234567"
+	repoDetails := reporeader.RepoDetails{
+		Tree: treeNode(),
+		Releases: reporeader.Releases{Releases: []reporeader.Release{
+			{Tag: reporeader.Tag{Name: "v1.0.0", Hash: "1111111111111111111111111111111111111111"}},
+			{Tag: reporeader.Tag{Name: "v1.1.0", Hash: head}},
+		}},
+		Branches: reporeader.Branches{Default: "main", Branches: []reporeader.Branch{
+			{Name: "feature", Hash: head, LastAuthor: reporeader.Author{Name: "Gitcha One", Email: "gitcha1@gitcha.com"}},
+		}},
+	}
+
+	tests := map[string]struct {
+		view     tui.View
+		key      string
+		expected string
+	}{
+		"given y key on tree should copy the selected path":                {view: tui.TreeView, key: "y", expected: "services"},
+		"given y key on releases should copy the commit of the tag":        {view: tui.ReleasesView, key: "y", expected: head},
+		"given y key on branches should copy the last commit":              {view: tui.BranchesView, key: "y", expected: head},
+		"given Y key on branches should copy the email of the last author": {view: tui.BranchesView, key: "Y", expected: "gitcha1@gitcha.com"},
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf strings.Builder
+			model := tui.EntryModel{Clipboard: clipboard.Clipboard{Output: &buf, Getenv: func(string) string { return "" }}}
+			updatedModel, _ := model.Update(tui.RepoDetailsMsg{RepoDetails: repoDetails})
+			model, ok := updatedModel.(tui.EntryModel)
+			require.True(t, ok)
+			model.ActiveView = test.view
+
+			_, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(test.key)})
+			require.NotNil(t, cmd)
+
+			var in, out bytes.Buffer
+			program := tea.NewProgram(copyModel{cmd: cmd}, tea.WithInput(&in), tea.WithOutput(&out))
+			finalModel, err := program.Run()
+			require.NoError(t, err)
+
+			actual, ok := finalModel.(copyModel)
+			require.True(t, ok)
+			assert.Equal(t, tui.CopiedMsg{Text: test.expected}, actual.copied)
+			assert.Contains(t, buf.String(), "\x1b]52;c;")
+		})
+	}
+
+	t.Run("given a view with a selection should list the copy keys", func(t *testing.T) {
+		t.Parallel()
+
+		updatedModel, _ := tui.EntryModel{}.Update(tui.RepoDetailsMsg{RepoDetails: repoDetails})
+		model, ok := updatedModel.(tui.EntryModel)
+		require.True(t, ok)
+
+		assert.NotContains(t, model.View(), "y to copy")
+
+		model.ActiveView = tui.ReleasesView
+		assert.Contains(t, model.View(), "y to copy")
+		assert.NotContains(t, model.View(), "Y to copy")
+
+		model.ActiveView = tui.BranchesView
+		assert.Contains(t, model.View(), "y to copy, Y to copy the author's email")
+	})
+
+	t.Run("given y key without selection should show notice", func(t *testing.T) {
+		t.Parallel()
+
+		model := tui.EntryModel{ActiveView: tui.OverviewView}
+
+		updatedModel, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+
+		actual, ok := updatedModel.(tui.EntryModel)
+		require.True(t, ok)
+
+		assert.Nil(t, cmd)
+		assert.Equal(t, "Nothing to copy for the selection", actual.Message)
+	})
+
+	t.Run("given CopiedMsg should show the result next to the tabs", func(t *testing.T) {
+		t.Parallel()
+
+		model := tui.EntryModel{}
+
+		updatedModel, _ := model.Update(tui.CopiedMsg{Text: head})
+		actual, ok := updatedModel.(tui.EntryModel)
+		require.True(t, ok)
+		assert.Equal(t, "Copied "+head, actual.Message)
+
+		updatedModel, _ = model.Update(tui.CopiedMsg{Text: head, Err: fmt.Errorf("closed")})
+		actual, ok = updatedModel.(tui.EntryModel)
+		require.True(t, ok)
+		assert.Equal(t, "Unable to copy "+head+": closed", actual.Message)
+	})
+}
+
+// copyModel runs the copy command of an EntryModel in a program and quits with the CopiedMsg it reports.
+type copyModel struct {
+	cmd    tea.Cmd
+	copied tui.CopiedMsg
+}
+
+func (m copyModel) Init() tea.Cmd {
+	return m.cmd
+}
+
+func (m copyModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if copied, ok := msg.(tui.CopiedMsg); ok {
+		m.copied = copied
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+func (m copyModel) View() string {
+	return ""
+}
+
+func treeNode() reporeader.TreeNode {
+	return reporeader.TreeNode{
+		IsDir: true,
+		Files: 1,
+		Children: []reporeader.TreeNode{
+			{
+				Name:  "services",
+				Path:  "services",
+				IsDir: true,
+				Files: 1,
+				Children: []reporeader.TreeNode{
+					{Name: "main.go", Path: "services/main.go", Files: 1},
+				},
+			},
+		},
+	}
 }
@@ -0,0 +1,151 @@
+package files
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/djyuhn/gitcha/internal/reporeader"
+	"github.com/djyuhn/gitcha/internal/tui/style"
+)
+
+const (
+	day = 24 * time.Hour
+
+	pathColumnWidth   = 50
+	countColumnWidth  = 8
+	dateColumnWidth   = 12
+	defaultViewHeight = 20
+
+	// headerHeight is the number of lines rendered above the table rows.
+	headerHeight = 3
+)
+
+// HotspotWindow is a window of time, back from the most recent change, that hotspots are ranked over.
+type HotspotWindow struct {
+	Label    string
+	Duration time.Duration
+}
+
+// HotspotWindows are the windows the Files view cycles through, starting with the whole history.
+var HotspotWindows = []HotspotWindow{
+	{Label: "All time", Duration: 0},
+	{Label: "Last year", Duration: 365 * day},
+	{Label: "Last 90 days", Duration: 90 * day},
+	{Label: "Last 30 days", Duration: 30 * day},
+}
+
+type Files struct {
+	FileHistories []reporeader.FileHistory
+	Window        int
+	Hotspots      []reporeader.FileStats
+
+	theme style.Theme
+	table table.Model
+}
+
+var _ tea.Model = Files{}
+
+func NewFiles(repoDetails reporeader.RepoDetails) Files {
+	defaultTheme := style.NewDefaultTheme()
+
+	styles := table.DefaultStyles()
+	styles.Header = styles.Header.Foreground(defaultTheme.General.PrimaryColor)
+	styles.Selected = styles.Selected.Foreground(defaultTheme.General.SecondaryColor)
+
+	t := table.New(
+		table.WithColumns([]table.Column{
+			{Title: "Path", Width: pathColumnWidth},
+			{Title: "Commits", Width: countColumnWidth},
+			{Title: "Authors", Width: countColumnWidth},
+			{Title: "Churn", Width: countColumnWidth},
+			{Title: "Modified", Width: dateColumnWidth},
+		}),
+		table.WithHeight(defaultViewHeight),
+		table.WithFocused(true),
+		table.WithStyles(styles),
+	)
+
+	f := Files{FileHistories: repoDetails.FileHistories, theme: *defaultTheme, table: t}
+	f.setWindow(0)
+
+	return f
+}
+
+func (f Files) Init() tea.Cmd {
+	return nil
+}
+
+func (f Files) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.String() == "w" {
+			f.setWindow((f.Window + 1) % len(HotspotWindows))
+			return f, nil
+		}
+	case tea.WindowSizeMsg:
+		f.SetHeight(msg.Height)
+		return f, nil
+	}
+
+	var cmd tea.Cmd
+	f.table, cmd = f.table.Update(msg)
+
+	return f, cmd
+}
+
+func (f Files) View() string {
+	primaryColorStyle := lipgloss.NewStyle().Foreground(f.theme.General.PrimaryColor)
+	secondaryColorStyle := lipgloss.NewStyle().Foreground(f.theme.General.SecondaryColor)
+
+	label := primaryColorStyle.Render("Hotspots:")
+	window := secondaryColorStyle.Render(fmt.Sprintf("%s (w to change)", HotspotWindows[f.Window].Label))
+
+	return fmt.Sprintf("%s %s\n\n%s", label, window, f.table.View())
+}
+
+// SetHeight sets the height available to the view, including its header.
+func (f *Files) SetHeight(height int) {
+	if height > headerHeight {
+		f.table.SetHeight(height - headerHeight)
+	}
+}
+
+// SetFileHistories replaces the file histories and ranks their hotspots over the current window.
+func (f *Files) SetFileHistories(histories []reporeader.FileHistory) {
+	f.FileHistories = histories
+	f.setWindow(f.Window)
+}
+
+// SelectedPath returns the path of the selected file, if any.
+func (f Files) SelectedPath() (string, bool) {
+	if len(f.table.Rows()) == 0 {
+		return "", false
+	}
+
+	return f.table.SelectedRow()[0], true
+}
+
+// setWindow ranks the hotspots over the window at index and updates the table rows.
+func (f *Files) setWindow(index int) {
+	f.Window = index
+	f.Hotspots = reporeader.Hotspots(f.FileHistories, HotspotWindows[index].Duration)
+
+	rows := make([]table.Row, 0, len(f.Hotspots))
+	for _, hotspot := range f.Hotspots {
+		rows = append(rows, table.Row{
+			hotspot.Path,
+			strconv.Itoa(hotspot.Commits),
+			strconv.Itoa(hotspot.Authors),
+			strconv.Itoa(hotspot.Churn),
+			hotspot.LastModified.Format("2006-01-02"),
+		})
+	}
+
+	f.table.SetRows(rows)
+	f.table.GotoTop()
+}
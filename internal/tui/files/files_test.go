@@ -0,0 +1,164 @@
+package files_test
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/djyuhn/gitcha/internal/reporeader"
+	"github.com/djyuhn/gitcha/internal/tui/files"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFiles(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return files model with hotspots over the whole history", func(t *testing.T) {
+		t.Parallel()
+
+		repoDetails := reporeader.RepoDetails{FileHistories: fileHistories()}
+
+		actual := files.NewFiles(repoDetails)
+
+		assert.Equal(t, repoDetails.FileHistories, actual.FileHistories)
+		assert.Equal(t, 0, actual.Window)
+		assert.Equal(t, reporeader.Hotspots(repoDetails.FileHistories, 0), actual.Hotspots)
+	})
+}
+
+func TestFiles_SetFileHistories(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given file histories should rank their hotspots over the current window", func(t *testing.T) {
+		t.Parallel()
+
+		model := files.NewFiles(reporeader.RepoDetails{})
+		model.Window = 1
+
+		model.SetFileHistories(fileHistories())
+
+		assert.Equal(t, fileHistories(), model.FileHistories)
+		assert.Equal(t, 1, model.Window)
+		assert.Equal(t, reporeader.Hotspots(fileHistories(), files.HotspotWindows[1].Duration), model.Hotspots)
+	})
+}
+
+func TestFiles_Init(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		model := files.NewFiles(reporeader.RepoDetails{})
+
+		assert.Nil(t, model.Init())
+	})
+}
+
+func TestFiles_Update(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given w key should cycle to the next hotspot window", func(t *testing.T) {
+		t.Parallel()
+
+		model := files.NewFiles(reporeader.RepoDetails{FileHistories: fileHistories()})
+
+		updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")})
+
+		actual, ok := updated.(files.Files)
+		require.True(t, ok)
+
+		assert.Nil(t, cmd)
+		assert.Equal(t, 1, actual.Window)
+		assert.Equal(t, reporeader.Hotspots(actual.FileHistories, files.HotspotWindows[1].Duration), actual.Hotspots)
+	})
+
+	t.Run("given w key on the last window should cycle back to the whole history", func(t *testing.T) {
+		t.Parallel()
+
+		var model tea.Model = files.NewFiles(reporeader.RepoDetails{FileHistories: fileHistories()})
+		for range files.HotspotWindows {
+			model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")})
+		}
+
+		actual, ok := model.(files.Files)
+		require.True(t, ok)
+
+		assert.Equal(t, 0, actual.Window)
+	})
+
+	t.Run("given down key should select the next file", func(t *testing.T) {
+		t.Parallel()
+
+		model := files.NewFiles(reporeader.RepoDetails{FileHistories: fileHistories()})
+
+		updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+		actual, ok := updated.(files.Files)
+		require.True(t, ok)
+
+		path, ok := actual.SelectedPath()
+		assert.True(t, ok)
+		assert.Equal(t, actual.Hotspots[1].Path, path)
+	})
+}
+
+func TestFiles_View(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return window label and hotspot paths in view", func(t *testing.T) {
+		t.Parallel()
+
+		model := files.NewFiles(reporeader.RepoDetails{FileHistories: fileHistories()})
+
+		actual := model.View()
+
+		assert.Contains(t, actual, files.HotspotWindows[0].Label)
+		assert.Contains(t, actual, "busy.go")
+		assert.Contains(t, actual, "quiet.go")
+	})
+}
+
+func TestFiles_SelectedPath(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given no files should return false", func(t *testing.T) {
+		t.Parallel()
+
+		model := files.NewFiles(reporeader.RepoDetails{})
+
+		path, ok := model.SelectedPath()
+
+		assert.False(t, ok)
+		assert.Empty(t, path)
+	})
+
+	t.Run("given files should return the hottest file first", func(t *testing.T) {
+		t.Parallel()
+
+		model := files.NewFiles(reporeader.RepoDetails{FileHistories: fileHistories()})
+
+		path, ok := model.SelectedPath()
+
+		assert.True(t, ok)
+		assert.Equal(t, "busy.go", path)
+	})
+}
+
+func fileHistories() []reporeader.FileHistory {
+	start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+
+	return []reporeader.FileHistory{
+		{
+			Path:    "quiet.go",
+			Changes: []reporeader.FileChange{{Hash: "b", Author: "gitcha2@gitcha.com", Date: start.Add(400 * 24 * time.Hour), Added: 1}},
+		},
+		{
+			Path:    "busy.go",
+			Changes: []reporeader.FileChange{{Hash: "a", Author: "gitcha1@gitcha.com", Date: start, Added: 100, Deleted: 20}},
+		},
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
@@ -13,23 +14,56 @@ import (
 	"github.com/djyuhn/gitcha/internal/tui/style"
 )
 
-const topAuthorCount = 3
+const (
+	topAuthorCount         = 3
+	topCoAuthorCount       = 3
+	topBotCount            = 3
+	topOwnerCount          = 3
+	topAuthorTimezoneCount = 3
+	topAuthorTypeCount     = 3
+	atRiskDirectoryCount   = 5
+	topConventionCount     = 5
+	conventionMonthCount   = 6
+	topPullRequestCount    = 3
+	mergeWeekCount         = 6
+	topRevertedCount       = 3
+	lowReferenceCount      = 3
+	lowSigningCount        = 3
+	topLargeBlobCount      = 3
+
+	percent = 100
+	day     = 24 * time.Hour
+
+	// byteUnit is the factor between successive binary size units such as KiB and MiB.
+	byteUnit = 1024
+)
+
+// punchcardGlyphs are the glyphs used for punchcard cells with commits, from the lowest to the highest activity.
+var punchcardGlyphs = []string{"░", "▒", "▓", "█"}
 
 type Overview struct {
 	RepoDetails reporeader.RepoDetails
 	theme       style.Theme
 
 	orderedAuthorsByCommitCount []AuthorCommitsPair
+	orderedBotsByCommitCount    []AuthorCommitsPair
+	orderedCoAuthorsByCommits   []AuthorCommitsPair
+	// coAuthoredCounts holds the number of commits each email is a co-author of.
+	coAuthoredCounts map[string]int
+
+	// viewport scrolls the overview once SetHeight gives it a height; until then the whole overview is rendered.
+	viewport viewport.Model
 }
 
 var _ tea.Model = Overview{}
 
 func NewOverview(repoDetails reporeader.RepoDetails) Overview {
-	topAuthorsByCommits := getSortedAuthorsByCommitCount(repoDetails.AuthorsCommits)
-
 	defaultTheme := style.NewDefaultTheme()
 
-	return Overview{RepoDetails: repoDetails, orderedAuthorsByCommitCount: topAuthorsByCommits, theme: *defaultTheme}
+	o := Overview{theme: *defaultTheme, viewport: viewport.New(0, 0)}
+	o.SetRepoDetails(repoDetails)
+
+	return o
 }
 
 func (o Overview) Init() tea.Cmd {
@@ -37,15 +71,129 @@ func (o Overview) Init() tea.Cmd {
 }
 
 func (o Overview) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	return o, nil
+	var cmd tea.Cmd
+	o.viewport, cmd = o.viewport.Update(msg)
+
+	return o, cmd
 }
 
 func (o Overview) View() string {
+	if o.viewport.Height == 0 {
+		return o.render()
+	}
+
+	return o.viewport.View()
+}
+
+// SetHeight sets the height available to the view, scrolling the overview when it is taller.
+func (o *Overview) SetHeight(height int) {
+	o.viewport.Height = height
+	o.viewport.SetContent(o.render())
+}
+
+// SetRepoDetails replaces the analyses shown, keeping the scroll position as far as the new overview allows.
+func (o *Overview) SetRepoDetails(repoDetails reporeader.RepoDetails) {
+	o.RepoDetails = repoDetails
+	o.orderedAuthorsByCommitCount = getSortedAuthorsByCommitCount(repoDetails.AuthorsCommits)
+	o.orderedBotsByCommitCount = getSortedAuthorsByCommitCount(repoDetails.BotCommits)
+	o.orderedCoAuthorsByCommits = getSortedCoAuthorsByCommitCount(repoDetails.AuthorsCommits)
+	o.coAuthoredCounts = make(map[string]int, len(o.orderedCoAuthorsByCommits))
+	for _, coAuthor := range o.orderedCoAuthorsByCommits {
+		o.coAuthoredCounts[coAuthor.AuthorEmail] = len(coAuthor.Commits)
+	}
+	o.viewport.SetContent(o.render())
+}
+
+// render renders every section of the overview.
+func (o Overview) render() string {
 	view := strings.Builder{}
 
 	view.WriteString(o.buildRepoCreatedDateView() + "\n")
 	view.WriteString(o.buildLicenseView() + "\n")
+	if o.RepoDetails.Status.Head != "" {
+		view.WriteString(o.buildStatusView() + "\n")
+	}
+	if len(o.RepoDetails.Remotes.Remotes) > 0 {
+		view.WriteString(o.buildRemoteView() + "\n")
+	}
+	if len(o.RepoDetails.Releases.Releases) > 0 {
+		view.WriteString(o.buildReleaseView() + "\n")
+		view.WriteString(o.buildVersionView() + "\n")
+	}
+	view.WriteString(o.buildTimezoneView() + "\n")
 	view.WriteString(o.buildAuthorView() + "\n")
+	if len(o.orderedCoAuthorsByCommits) > 0 {
+		view.WriteString(o.buildCoAuthorView() + "\n")
+	}
+	if len(o.orderedBotsByCommitCount) > 0 {
+		view.WriteString(o.buildBotView() + "\n")
+	}
+	if !o.RepoDetails.IsDeferred(reporeader.AnalysisAuthorship) {
+		view.WriteString(o.buildOwnerView() + "\n")
+	}
+	if o.RepoDetails.Conventions.Repository.Commits > 0 {
+		view.WriteString(o.buildConventionView() + "\n")
+	}
+	if len(o.RepoDetails.Merges.PullRequests) > 0 {
+		view.WriteString(o.buildPullRequestView() + "\n")
+	}
+	if o.RepoDetails.Reverts.Commits > 0 {
+		view.WriteString(o.buildRevertView() + "\n")
+	}
+	if o.RepoDetails.Issues.Commits > 0 {
+		view.WriteString(o.buildIssueView() + "\n")
+	}
+	if o.RepoDetails.Signatures.Commits > 0 {
+		view.WriteString(o.buildSignatureView() + "\n")
+	}
+	if len(o.RepoDetails.Size.LargestBlobs) > 0 {
+		view.WriteString(o.buildSizeView() + "\n")
+	}
+	if !o.RepoDetails.IsDeferred(reporeader.AnalysisAuthorship) {
+		view.WriteString(o.buildBusFactorView() + "\n")
+	}
+	view.WriteString(o.buildPunchcardView() + "\n")
+	if len(o.RepoDetails.Deferred) > 0 {
+		view.WriteString(o.buildDeferredView() + "\n")
+	}
+	if len(o.RepoDetails.Errors) > 0 {
+		view.WriteString(o.buildErrorView() + "\n")
+	}
+
+	return view.String()
+}
+
+// buildDeferredView lists the analyses that were not run and left their sections out of the overview.
+func (o Overview) buildDeferredView() string {
+	primaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.PrimaryColor)
+	secondaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.SecondaryColor)
+
+	analyses := make([]string, 0, len(o.RepoDetails.Deferred))
+	for _, analysis := range o.RepoDetails.Deferred {
+		analyses = append(analyses, string(analysis))
+	}
+
+	return fmt.Sprintf("%s %s\n", primaryColorStyle.Render("Not analyzed yet:"),
+		secondaryColorStyle.Render(strings.Join(analyses, " ")))
+}
+
+// buildErrorView lists the analyses that failed and left their sections out of the overview.
+func (o Overview) buildErrorView() string {
+	view := strings.Builder{}
+
+	primaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.PrimaryColor)
+	secondaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.SecondaryColor)
+
+	analyses := make([]string, 0, len(o.RepoDetails.Errors))
+	for analysis := range o.RepoDetails.Errors {
+		analyses = append(analyses, analysis)
+	}
+	sort.Strings(analyses)
+
+	view.WriteString(primaryColorStyle.Render("Unable to analyze:") + "\n")
+	for _, analysis := range analyses {
+		view.WriteString(secondaryColorStyle.Render(fmt.Sprintf("%s: %s", analysis, o.RepoDetails.Errors[analysis])) + "\n")
+	}
 
 	return view.String()
 }
@@ -78,6 +226,105 @@ func (o Overview) buildLicenseView() string {
 	return view.String()
 }
 
+// buildStatusView shows the checked out branch or detached HEAD with its upstream, and the changes in the worktree.
+func (o Overview) buildStatusView() string {
+	primaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.PrimaryColor)
+	secondaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.SecondaryColor)
+
+	status := o.RepoDetails.Status
+
+	branch := status.Branch
+	if status.Detached {
+		branch = "detached HEAD at " + reporeader.ShortHash(status.Head)
+	}
+	if status.Upstream != "" {
+		branch += " tracking " + status.Upstream
+	}
+
+	worktree := "clean"
+	if !status.Clean() {
+		worktree = fmt.Sprintf("%d staged, %d modified, %d untracked, %d conflicted",
+			status.Staged, status.Modified, status.Untracked, status.Conflicted)
+	}
+
+	return fmt.Sprintf("%s %s\n%s %s", primaryColorStyle.Render("Branch:"), secondaryColorStyle.Render(branch),
+		primaryColorStyle.Render("Worktree:"), secondaryColorStyle.Render(worktree))
+}
+
+// buildRemoteView shows the web address of the hosted project and the configured remotes.
+func (o Overview) buildRemoteView() string {
+	view := strings.Builder{}
+
+	primaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.PrimaryColor)
+	secondaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.SecondaryColor)
+
+	if project, ok := o.RepoDetails.Remotes.Project(); ok {
+		view.WriteString(fmt.Sprintf("%s %s\n", primaryColorStyle.Render("Project:"),
+			secondaryColorStyle.Render(fmt.Sprintf("%s (%s)", project.WebURL(), project.Provider))))
+	}
+
+	for i, remote := range o.RepoDetails.Remotes.Remotes {
+		if i > 0 {
+			view.WriteString("\n")
+		}
+		view.WriteString(fmt.Sprintf("%s %s", primaryColorStyle.Render("Remote:"),
+			secondaryColorStyle.Render(fmt.Sprintf("%s %s", remote.Name, strings.Join(remote.URLs, " ")))))
+	}
+
+	return view.String()
+}
+
+// buildReleaseView summarizes the release cadence of the repository.
+func (o Overview) buildReleaseView() string {
+	primaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.PrimaryColor)
+	secondaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.SecondaryColor)
+
+	releases := o.RepoDetails.Releases
+	latest := releases.Releases[len(releases.Releases)-1]
+
+	cadence := fmt.Sprintf("%d, latest %s %s", len(releases.Releases), latest.Tag.Name, latest.Tag.Date.Format("2006-01-02"))
+	if len(releases.Releases) > 1 {
+		cadence += fmt.Sprintf(", every %.1f days on average", float64(releases.AverageInterval)/float64(day))
+	}
+
+	return fmt.Sprintf("%s %s", primaryColorStyle.Render("Releases:"), secondaryColorStyle.Render(cadence))
+}
+
+// buildVersionView shows the latest versions tagged and flags the tags that are not valid or ordered semantic
+// versions.
+func (o Overview) buildVersionView() string {
+	view := strings.Builder{}
+
+	primaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.PrimaryColor)
+	secondaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.SecondaryColor)
+
+	versions := o.RepoDetails.Versions
+
+	latest := make([]string, 0)
+	if versions.LatestStable != "" {
+		latest = append(latest, "stable "+versions.LatestStable)
+	}
+	if versions.LatestPreRelease != "" {
+		latest = append(latest, "pre-release "+versions.LatestPreRelease)
+	}
+	if len(latest) == 0 {
+		latest = append(latest, "no semantic versions")
+	}
+	view.WriteString(fmt.Sprintf("%s %s", primaryColorStyle.Render("Latest version:"),
+		secondaryColorStyle.Render(strings.Join(latest, ", "))))
+
+	if len(versions.Malformed) > 0 {
+		view.WriteString(fmt.Sprintf("\n%s %s", primaryColorStyle.Render("Malformed tags:"),
+			secondaryColorStyle.Render(strings.Join(versions.Malformed, " "))))
+	}
+	if len(versions.OutOfOrder) > 0 {
+		view.WriteString(fmt.Sprintf("\n%s %s", primaryColorStyle.Render("Out of order tags:"),
+			secondaryColorStyle.Render(strings.Join(versions.OutOfOrder, " "))))
+	}
+
+	return view.String()
+}
+
 func (o Overview) buildAuthorView() string {
 	view := strings.Builder{}
 
@@ -96,12 +343,459 @@ func (o Overview) buildAuthorView() string {
 		email := secondaryColorStyle.Render(o.orderedAuthorsByCommitCount[i].AuthorEmail)
 		count := secondaryColorStyle.Render(fmt.Sprintf("%d", len(o.orderedAuthorsByCommitCount[i].Commits)))
 
+		view.WriteString(fmt.Sprintf("%s %s %s %s", label, name, email, count))
+
+		if coAuthored := o.coAuthoredCounts[o.orderedAuthorsByCommitCount[i].AuthorEmail]; coAuthored > 0 {
+			view.WriteString(" " + secondaryColorStyle.Render(fmt.Sprintf("(%d co-authored)", coAuthored)))
+		}
+
+		authorTimezones := o.RepoDetails.Timezones.Authors[o.orderedAuthorsByCommitCount[i].AuthorEmail]
+		if len(authorTimezones) > 0 {
+			view.WriteString(" " + secondaryColorStyle.Render(formatTimezoneDistribution(authorTimezones, topAuthorTimezoneCount)))
+		}
+
+		authorConventions := o.RepoDetails.Conventions.Authors[o.orderedAuthorsByCommitCount[i].AuthorEmail]
+		if len(authorConventions.Types) > 0 {
+			types := formatCounts(authorConventions.SortedTypes(), authorConventions.Types, topAuthorTypeCount)
+			view.WriteString(" " + secondaryColorStyle.Render(types))
+		}
+
+		view.WriteString("\n")
+	}
+
+	return view.String()
+}
+
+// buildCoAuthorView lists the authors credited in the co-author trailers of the most commits, who are otherwise only
+// listed as authors of the commits credited to them.
+func (o Overview) buildCoAuthorView() string {
+	view := strings.Builder{}
+
+	primaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.PrimaryColor)
+	secondaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.SecondaryColor)
+
+	for i, coAuthor := range o.orderedCoAuthorsByCommits {
+		if i >= topCoAuthorCount {
+			break
+		}
+
+		label := primaryColorStyle.Render("Co-author:")
+		name := secondaryColorStyle.Render(coAuthor.AuthorName)
+		email := secondaryColorStyle.Render(coAuthor.AuthorEmail)
+		count := secondaryColorStyle.Render(fmt.Sprintf("%d", len(coAuthor.Commits)))
+
 		view.WriteString(fmt.Sprintf("%s %s %s %s\n", label, name, email, count))
 	}
 
 	return view.String()
 }
 
+// buildBotView lists the bots with the most commits when bots are grouped separately from the authors.
+func (o Overview) buildBotView() string {
+	view := strings.Builder{}
+
+	primaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.PrimaryColor)
+	secondaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.SecondaryColor)
+
+	for i, bot := range o.orderedBotsByCommitCount {
+		if i >= topBotCount {
+			break
+		}
+
+		label := primaryColorStyle.Render("Bot:")
+		name := secondaryColorStyle.Render(bot.AuthorName)
+		email := secondaryColorStyle.Render(bot.AuthorEmail)
+		count := secondaryColorStyle.Render(fmt.Sprintf("%d", len(bot.Commits)))
+
+		view.WriteString(fmt.Sprintf("%s %s %s %s\n", label, name, email, count))
+	}
+
+	return view.String()
+}
+
+func (o Overview) buildOwnerView() string {
+	view := strings.Builder{}
+
+	owners := o.RepoDetails.Ownership.Repository.Owners
+
+	ownerCount := topOwnerCount
+	if len(owners) < ownerCount {
+		ownerCount = len(owners)
+	}
+
+	for i := 0; i < ownerCount; i++ {
+		primaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.PrimaryColor)
+		secondaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.SecondaryColor)
+
+		label := primaryColorStyle.Render("Owner:")
+		email := secondaryColorStyle.Render(owners[i].Email)
+		share := secondaryColorStyle.Render(fmt.Sprintf("%.1f%%", owners[i].Percent))
+		lines := secondaryColorStyle.Render(fmt.Sprintf("%d lines", owners[i].Lines))
+
+		view.WriteString(fmt.Sprintf("%s %s %s %s\n", label, email, share, lines))
+	}
+
+	return view.String()
+}
+
+// buildConventionView summarizes how closely commits follow the Conventional Commits specification, the most common
+// types and scopes and the share of conforming commits in the most recent months.
+func (o Overview) buildConventionView() string {
+	view := strings.Builder{}
+
+	primaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.PrimaryColor)
+	secondaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.SecondaryColor)
+
+	conventions := o.RepoDetails.Conventions
+	repository := conventions.Repository
+
+	label := primaryColorStyle.Render("Conventional commits:")
+	adherence := secondaryColorStyle.Render(fmt.Sprintf("%d of %d (%.1f%% non-conforming), %d breaking",
+		repository.Conforming, repository.Commits, repository.NonConformingShare(), repository.Breaking))
+	view.WriteString(fmt.Sprintf("%s %s\n", label, adherence))
+
+	if len(repository.Types) > 0 {
+		types := formatCounts(repository.SortedTypes(), repository.Types, topConventionCount)
+		view.WriteString(fmt.Sprintf("%s %s\n", primaryColorStyle.Render("Types:"), secondaryColorStyle.Render(types)))
+	}
+	if len(repository.Scopes) > 0 {
+		scopes := formatCounts(repository.SortedScopes(), repository.Scopes, topConventionCount)
+		view.WriteString(fmt.Sprintf("%s %s\n", primaryColorStyle.Render("Scopes:"), secondaryColorStyle.Render(scopes)))
+	}
+
+	months := conventions.SortedMonths()
+	if len(months) > conventionMonthCount {
+		months = months[len(months)-conventionMonthCount:]
+	}
+	monthlyAdherence := make([]string, 0, len(months))
+	for _, month := range months {
+		breakdown := conventions.Months[month]
+		monthlyAdherence = append(monthlyAdherence, fmt.Sprintf("%s %.0f%%", month, percent-breakdown.NonConformingShare()))
+	}
+	view.WriteString(fmt.Sprintf("%s %s\n", primaryColorStyle.Render("Adherence:"),
+		secondaryColorStyle.Render(strings.Join(monthlyAdherence, " "))))
+
+	return view.String()
+}
+
+// buildPullRequestView summarizes the pull requests merged into the checked out branch: how many, how long they took
+// from their first commit, who authored the most and how many were merged in the most recent weeks.
+func (o Overview) buildPullRequestView() string {
+	view := strings.Builder{}
+
+	primaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.PrimaryColor)
+	secondaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.SecondaryColor)
+
+	merges := o.RepoDetails.Merges
+
+	squashed := 0
+	for _, pullRequest := range merges.PullRequests {
+		if pullRequest.Squashed {
+			squashed++
+		}
+	}
+
+	summary := fmt.Sprintf("%d merged (%d squashed), %d merge commits", len(merges.PullRequests), squashed, merges.MergeCommits)
+	if squashed < len(merges.PullRequests) {
+		summary += fmt.Sprintf(", %.1f days from first commit on average (median %.1f days)",
+			float64(merges.AverageLeadTime)/float64(day), float64(merges.MedianLeadTime)/float64(day))
+	}
+	view.WriteString(fmt.Sprintf("%s %s\n", primaryColorStyle.Render("Pull requests:"), secondaryColorStyle.Render(summary)))
+
+	authors := formatCounts(merges.SortedAuthors(), merges.Authors, topPullRequestCount)
+	view.WriteString(fmt.Sprintf("%s %s\n", primaryColorStyle.Render("Pull request authors:"), secondaryColorStyle.Render(authors)))
+
+	weeks := merges.SortedWeeks()
+	if len(weeks) > mergeWeekCount {
+		weeks = weeks[len(weeks)-mergeWeekCount:]
+	}
+	weekly := make([]string, 0, len(weeks))
+	for _, week := range weeks {
+		weekly = append(weekly, fmt.Sprintf("%s %d", week, merges.Weekly[week]))
+	}
+	view.WriteString(fmt.Sprintf("%s %s\n", primaryColorStyle.Render("Merged per week:"),
+		secondaryColorStyle.Render(strings.Join(weekly, " "))))
+
+	return view.String()
+}
+
+// buildRevertView shows the share of commits that are reverts and fixes and the files and authors most often reverted.
+func (o Overview) buildRevertView() string {
+	view := strings.Builder{}
+
+	primaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.PrimaryColor)
+	secondaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.SecondaryColor)
+
+	reverts := o.RepoDetails.Reverts
+
+	summary := fmt.Sprintf("%d of %d commits (%.1f%%), %d fixes (%.1f%%)",
+		len(reverts.Reverts), reverts.Commits, reverts.RevertShare(), reverts.Fixes, reverts.FixShare())
+	view.WriteString(fmt.Sprintf("%s %s\n", primaryColorStyle.Render("Reverts:"), secondaryColorStyle.Render(summary)))
+
+	if len(reverts.Files) > 0 {
+		files := formatCounts(reverts.SortedFiles(), reverts.Files, topRevertedCount)
+		view.WriteString(fmt.Sprintf("%s %s\n", primaryColorStyle.Render("Most reverted files:"), secondaryColorStyle.Render(files)))
+	}
+	if len(reverts.Authors) > 0 {
+		authors := formatCounts(reverts.SortedAuthors(), reverts.Authors, topRevertedCount)
+		view.WriteString(fmt.Sprintf("%s %s\n", primaryColorStyle.Render("Most reverted authors:"), secondaryColorStyle.Render(authors)))
+	}
+
+	return view.String()
+}
+
+// buildIssueView shows the share of commits referencing an issue and the authors with the lowest reference rates.
+func (o Overview) buildIssueView() string {
+	view := strings.Builder{}
+
+	primaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.PrimaryColor)
+	secondaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.SecondaryColor)
+
+	issues := o.RepoDetails.Issues
+
+	summary := fmt.Sprintf("%d of %d commits (%.1f%%), %d issues, %d commits without a reference",
+		issues.Referencing, issues.Commits, issues.Share(), len(issues.Issues), len(issues.Unreferenced))
+	view.WriteString(fmt.Sprintf("%s %s\n", primaryColorStyle.Render("Issue references:"), secondaryColorStyle.Render(summary)))
+
+	authors := issues.SortedAuthors()
+	if len(authors) > lowReferenceCount {
+		authors = authors[:lowReferenceCount]
+	}
+	rates := make([]string, 0, len(authors))
+	for _, email := range authors {
+		rates = append(rates, fmt.Sprintf("%s (%.1f%%)", email, issues.Authors[email].Share()))
+	}
+	view.WriteString(fmt.Sprintf("%s %s\n", primaryColorStyle.Render("Lowest reference rates:"),
+		secondaryColorStyle.Render(strings.Join(rates, " "))))
+
+	return view.String()
+}
+
+// buildSignatureView shows the share of signed commits by signature type, the authors with the lowest signing rates and
+// the commits of the protected branches that are not properly signed.
+func (o Overview) buildSignatureView() string {
+	view := strings.Builder{}
+
+	primaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.PrimaryColor)
+	secondaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.SecondaryColor)
+
+	signatures := o.RepoDetails.Signatures
+
+	types := make([]string, 0, len(signatures.Types))
+	for _, signatureType := range []reporeader.SignatureType{
+		reporeader.SignatureGPG, reporeader.SignatureSSH, reporeader.SignatureX509, reporeader.SignatureUnknown,
+	} {
+		if count := signatures.Types[signatureType]; count > 0 {
+			types = append(types, fmt.Sprintf("%s %d", signatureType, count))
+		}
+	}
+
+	summary := fmt.Sprintf("%d of %d commits (%.1f%%)", signatures.Signed, signatures.Commits, signatures.Share())
+	if len(types) > 0 {
+		summary += ", " + strings.Join(types, " ")
+	}
+	if signatures.Checked {
+		summary += fmt.Sprintf(", %d verified (%.1f%%)", signatures.Verified, signatures.VerifiedShare())
+	}
+	view.WriteString(fmt.Sprintf("%s %s\n", primaryColorStyle.Render("Signed commits:"), secondaryColorStyle.Render(summary)))
+
+	authors := signatures.SortedAuthors()
+	if len(authors) > lowSigningCount {
+		authors = authors[:lowSigningCount]
+	}
+	rates := make([]string, 0, len(authors))
+	for _, email := range authors {
+		rates = append(rates, fmt.Sprintf("%s (%.1f%%)", email, signatures.Authors[email].Share()))
+	}
+	view.WriteString(fmt.Sprintf("%s %s\n", primaryColorStyle.Render("Lowest signing rates:"),
+		secondaryColorStyle.Render(strings.Join(rates, " "))))
+
+	for _, branch := range signatures.Protected {
+		unsigned := fmt.Sprintf("%d of %d commits", len(branch.Unsigned), branch.Commits)
+		if signatures.Checked {
+			unsigned += fmt.Sprintf(", %d not verified", len(branch.Unverified))
+		}
+		view.WriteString(fmt.Sprintf("%s %s\n", primaryColorStyle.Render(fmt.Sprintf("Unsigned on %s:", branch.Name)),
+			secondaryColorStyle.Render(unsigned)))
+	}
+
+	return view.String()
+}
+
+// buildSizeView shows the size of the object database, the size of the blobs no longer at HEAD that a history rewrite
+// could reclaim and the largest blobs ever committed.
+func (o Overview) buildSizeView() string {
+	view := strings.Builder{}
+
+	primaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.PrimaryColor)
+	secondaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.SecondaryColor)
+
+	size := o.RepoDetails.Size
+
+	summary := fmt.Sprintf("%s on disk, %d packs, %d loose objects", formatBytes(size.DiskSize()), size.Packs.Count,
+		size.Loose.Count)
+	view.WriteString(fmt.Sprintf("%s %s\n", primaryColorStyle.Render("Repository size:"), secondaryColorStyle.Render(summary)))
+
+	blobs := fmt.Sprintf("%d at HEAD (%s), %d only in history (%s)", size.HeadBlobs.Count, formatBytes(size.HeadBlobs.Size),
+		size.HistoryBlobs.Count, formatBytes(size.HistoryBlobs.Size))
+	view.WriteString(fmt.Sprintf("%s %s\n", primaryColorStyle.Render("Committed blobs:"), secondaryColorStyle.Render(blobs)))
+
+	largestBlobs := size.LargestBlobs
+	if len(largestBlobs) > topLargeBlobCount {
+		largestBlobs = largestBlobs[:topLargeBlobCount]
+	}
+	largest := make([]string, 0, len(largestBlobs))
+	for _, blob := range largestBlobs {
+		if blob.AtHead {
+			largest = append(largest, fmt.Sprintf("%s (%s)", blob.Path, formatBytes(blob.Size)))
+		} else {
+			largest = append(largest, fmt.Sprintf("%s (%s, removed)", blob.Path, formatBytes(blob.Size)))
+		}
+	}
+	view.WriteString(fmt.Sprintf("%s %s\n", primaryColorStyle.Render("Largest blobs:"),
+		secondaryColorStyle.Render(strings.Join(largest, " "))))
+
+	return view.String()
+}
+
+// formatBytes formats a size in bytes with the largest binary unit it reaches, such as "1.5 MiB".
+func formatBytes(size int64) string {
+	if size < byteUnit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	value := float64(size) / byteUnit
+	units := []string{"KiB", "MiB", "GiB", "TiB"}
+	unit := 0
+	for value >= byteUnit && unit < len(units)-1 {
+		value /= byteUnit
+		unit++
+	}
+
+	return fmt.Sprintf("%.1f %s", value, units[unit])
+}
+
+// formatCounts formats the first limit keys with their counts, such as "feat (3) fix (2)".
+func formatCounts(keys []string, counts map[string]int, limit int) string {
+	if len(keys) > limit {
+		keys = keys[:limit]
+	}
+
+	formatted := make([]string, 0, len(keys))
+	for _, key := range keys {
+		formatted = append(formatted, fmt.Sprintf("%s (%d)", key, counts[key]))
+	}
+
+	return strings.Join(formatted, " ")
+}
+
+func (o Overview) buildBusFactorView() string {
+	view := strings.Builder{}
+
+	primaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.PrimaryColor)
+	secondaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.SecondaryColor)
+
+	busFactor := o.RepoDetails.BusFactor
+
+	label := primaryColorStyle.Render("Bus factor:")
+	factor := secondaryColorStyle.Render(fmt.Sprintf("%d", busFactor.Factor))
+	view.WriteString(fmt.Sprintf("%s %s", label, factor))
+	if len(busFactor.Authors) > 0 {
+		view.WriteString(" " + secondaryColorStyle.Render(strings.Join(busFactor.Authors, " ")))
+	}
+	view.WriteString("\n")
+
+	label = primaryColorStyle.Render("At risk:")
+	files := secondaryColorStyle.Render(fmt.Sprintf("%d of %d files", len(busFactor.AtRiskFiles), busFactor.FileCount))
+	view.WriteString(fmt.Sprintf("%s %s\n", label, files))
+
+	directoryCount := atRiskDirectoryCount
+	if len(busFactor.AtRiskDirectories) < directoryCount {
+		directoryCount = len(busFactor.AtRiskDirectories)
+	}
+
+	for i := 0; i < directoryCount; i++ {
+		label := primaryColorStyle.Render("At risk directory:")
+		directory := secondaryColorStyle.Render(busFactor.AtRiskDirectories[i])
+
+		view.WriteString(fmt.Sprintf("%s %s\n", label, directory))
+	}
+
+	return view.String()
+}
+
+func (o Overview) buildTimezoneView() string {
+	view := strings.Builder{}
+
+	primaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.PrimaryColor)
+	secondaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.SecondaryColor)
+
+	repoTimezones := o.RepoDetails.Timezones.Repository
+
+	labelView := primaryColorStyle.Render("Timezones:")
+	timezonesView := secondaryColorStyle.Render(formatTimezoneDistribution(repoTimezones, len(repoTimezones)))
+
+	view.WriteString(fmt.Sprintf("%s %s", labelView, timezonesView))
+
+	return view.String()
+}
+
+// formatTimezoneDistribution formats up to limit of the most common UTC offsets of the distribution with their commit
+// counts.
+func formatTimezoneDistribution(distribution reporeader.TimezoneDistribution, limit int) string {
+	offsets := distribution.Offsets()
+	if len(offsets) > limit {
+		offsets = offsets[:limit]
+	}
+
+	formatted := make([]string, 0, len(offsets))
+	for _, offset := range offsets {
+		formatted = append(formatted, fmt.Sprintf("%s (%d)", reporeader.FormatUTCOffset(offset), distribution[offset]))
+	}
+
+	return strings.Join(formatted, " ")
+}
+
+func (o Overview) buildPunchcardView() string {
+	view := strings.Builder{}
+
+	primaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.PrimaryColor)
+	secondaryColorStyle := lipgloss.NewStyle().Foreground(o.theme.General.SecondaryColor)
+
+	punchcard := o.RepoDetails.Punchcard
+	highest := punchcard.Max()
+
+	header := strings.Builder{}
+	header.WriteString("Punchcard:")
+	for hour := range punchcard[time.Sunday] {
+		header.WriteString(fmt.Sprintf("%3d", hour))
+	}
+	view.WriteString(primaryColorStyle.Render(header.String()) + "\n")
+
+	for day, hours := range punchcard {
+		row := strings.Builder{}
+		for _, count := range hours {
+			row.WriteString(fmt.Sprintf("%3s", punchcardGlyph(count, highest)))
+		}
+
+		label := primaryColorStyle.Render(fmt.Sprintf("%-10s", time.Weekday(day).String()[:3]))
+		view.WriteString(label + secondaryColorStyle.Render(row.String()) + "\n")
+	}
+
+	return view.String()
+}
+
+// punchcardGlyph returns the glyph representing count relative to the highest count of the punchcard.
+func punchcardGlyph(count, highest int) string {
+	if count == 0 || highest == 0 {
+		return "·"
+	}
+
+	level := (count*len(punchcardGlyphs) + highest - 1) / highest
+
+	return punchcardGlyphs[level-1]
+}
+
 type AuthorCommitsPair struct {
 	AuthorName  string
 	AuthorEmail string
@@ -117,8 +811,10 @@ func getSortedAuthorsByCommitCount(authorCommits map[string][]reporeader.Commit)
 		if len(commits) == 0 {
 			continue
 		}
+		// Commits may be credited through a co-author trailer, so the name is looked up by email.
+		author, _ := commits[len(commits)-1].Contributor(email)
 		pair := AuthorCommitsPair{
-			AuthorName:  commits[len(commits)-1].Author.Name,
+			AuthorName:  author.Name,
 			AuthorEmail: email,
 			Commits:     commits,
 		}
@@ -132,3 +828,42 @@ func getSortedAuthorsByCommitCount(authorCommits map[string][]reporeader.Commit)
 
 	return authorCommitPairs
 }
+
+// getSortedCoAuthorsByCommitCount returns the co-authors of the commits of authorCommits along with the commits they
+// co-authored, each counted once however many authors it is credited to.
+//
+// The slice is ordered by the highest to the lowest commit count, then by email.
+func getSortedCoAuthorsByCommitCount(authorCommits map[string][]reporeader.Commit) []AuthorCommitsPair {
+	seen := make(map[string]bool)
+	byEmail := make(map[string]*AuthorCommitsPair)
+	for _, commits := range authorCommits {
+		for _, commit := range commits {
+			if seen[commit.Hash] {
+				continue
+			}
+			seen[commit.Hash] = true
+
+			for _, coAuthor := range commit.CoAuthors {
+				pair, ok := byEmail[coAuthor.Email]
+				if !ok {
+					pair = &AuthorCommitsPair{AuthorName: coAuthor.Name, AuthorEmail: coAuthor.Email}
+					byEmail[coAuthor.Email] = pair
+				}
+				pair.Commits = append(pair.Commits, commit)
+			}
+		}
+	}
+
+	coAuthors := make([]AuthorCommitsPair, 0, len(byEmail))
+	for _, pair := range byEmail {
+		coAuthors = append(coAuthors, *pair)
+	}
+	sort.Slice(coAuthors, func(i, j int) bool {
+		if len(coAuthors[i].Commits) != len(coAuthors[j].Commits) {
+			return len(coAuthors[i].Commits) > len(coAuthors[j].Commits)
+		}
+		return coAuthors[i].AuthorEmail < coAuthors[j].AuthorEmail
+	})
+
+	return coAuthors
+}
@@ -7,10 +7,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/djyuhn/gitcha/internal/hosting"
 	"github.com/djyuhn/gitcha/internal/reporeader"
 	"github.com/djyuhn/gitcha/internal/tui/overview"
 	"github.com/djyuhn/gitcha/internal/tui/style"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/stretchr/testify/assert"
 )
@@ -63,6 +65,45 @@ func TestOverview_Update(t *testing.T) {
 	})
 }
 
+func TestOverview_SetHeight(t *testing.T) {
+	t.Parallel()
+
+	repoDetails := reporeader.RepoDetails{
+		CreatedDate: time.Date(2023, time.January, 26, 3, 2, 1, 0, time.UTC),
+		License:     "SOME LICENSE",
+	}
+
+	t.Run("given height shorter than the overview should show only as many lines and scroll with the down key", func(t *testing.T) {
+		t.Parallel()
+
+		model := overview.NewOverview(repoDetails)
+		model.SetHeight(3)
+
+		view := model.View()
+		assert.Len(t, strings.Split(view, "\n"), 3)
+		assert.Contains(t, view, "Created:")
+
+		updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyDown})
+		scrolled, ok := updated.(overview.Overview)
+		assert.True(t, ok)
+		assert.NotContains(t, scrolled.View(), "Created:")
+	})
+
+	t.Run("given new repo details should keep the scroll position", func(t *testing.T) {
+		t.Parallel()
+
+		model := overview.NewOverview(repoDetails)
+		model.SetHeight(3)
+		updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyDown})
+		scrolled, ok := updated.(overview.Overview)
+		assert.True(t, ok)
+
+		scrolled.SetRepoDetails(repoDetails)
+
+		assert.NotContains(t, scrolled.View(), "Created:")
+	})
+}
+
 func TestOverview_View(t *testing.T) {
 	t.Parallel()
 
@@ -214,6 +255,490 @@ func TestOverview_View(t *testing.T) {
 
 		assert.Contains(t, actual, expectedView)
 	})
+	t.Run("given punchcard should return weekday rows with glyphs scaled to the busiest hour in view", func(t *testing.T) {
+		t.Parallel()
+
+		punchcard := reporeader.Punchcard{}
+		punchcard[time.Monday][9] = 4
+		punchcard[time.Monday][10] = 1
+
+		repoDetails := reporeader.RepoDetails{Punchcard: punchcard}
+		model := overview.NewOverview(repoDetails)
+
+		defaultTheme := style.NewDefaultTheme()
+
+		primaryColorStyle := lipgloss.NewStyle().Foreground(defaultTheme.General.PrimaryColor)
+		secondaryColorStyle := lipgloss.NewStyle().Foreground(defaultTheme.General.SecondaryColor)
+
+		row := strings.Builder{}
+		for hour := 0; hour < 24; hour++ {
+			glyph := "·"
+			switch hour {
+			case 9:
+				glyph = "█"
+			case 10:
+				glyph = "░"
+			}
+			row.WriteString(fmt.Sprintf("%3s", glyph))
+		}
+		expectedView := primaryColorStyle.Render(fmt.Sprintf("%-10s", "Mon")) + secondaryColorStyle.Render(row.String())
+
+		actual := model.View()
+
+		assert.Contains(t, actual, expectedView)
+	})
+	t.Run("given timezones should return repository timezones and author timezones in view", func(t *testing.T) {
+		t.Parallel()
+
+		const tokyoOffset = 9 * 60 * 60
+		const berlinOffset = 1 * 60 * 60
+
+		author := reporeader.Author{Name: "Gitcha One", Email: "gitcha1@gitcha.com"}
+		authorCommits := map[string][]reporeader.Commit{
+			author.Email: {{Author: author, Message: "Message", Hash: "Hash"}},
+		}
+		repoDetails := reporeader.RepoDetails{
+			AuthorsCommits: authorCommits,
+			Timezones: reporeader.Timezones{
+				Repository: reporeader.TimezoneDistribution{tokyoOffset: 2, berlinOffset: 1},
+				Authors: map[string]reporeader.TimezoneDistribution{
+					author.Email: {tokyoOffset: 1},
+				},
+			},
+		}
+		model := overview.NewOverview(repoDetails)
+
+		defaultTheme := style.NewDefaultTheme()
+
+		primaryColorStyle := lipgloss.NewStyle().Foreground(defaultTheme.General.PrimaryColor)
+		secondaryColorStyle := lipgloss.NewStyle().Foreground(defaultTheme.General.SecondaryColor)
+
+		expectedRepoView := fmt.Sprintf("%s %s", primaryColorStyle.Render("Timezones:"),
+			secondaryColorStyle.Render("UTC+09:00 (2) UTC+01:00 (1)"))
+		expectedAuthorView := fmt.Sprintf("%s %s %s %s %s\n",
+			primaryColorStyle.Render("Author:"),
+			secondaryColorStyle.Render(author.Name),
+			secondaryColorStyle.Render(author.Email),
+			secondaryColorStyle.Render("1"),
+			secondaryColorStyle.Render("UTC+09:00 (1)"))
+
+		actual := model.View()
+
+		assert.Contains(t, actual, expectedRepoView)
+		assert.Contains(t, actual, expectedAuthorView)
+	})
+	t.Run("given bus factor should return factor, authors and at risk files and directories in view", func(t *testing.T) {
+		t.Parallel()
+
+		repoDetails := reporeader.RepoDetails{
+			BusFactor: reporeader.BusFactor{
+				Factor:            1,
+				Authors:           []string{"gitcha1@gitcha.com"},
+				FileCount:         3,
+				AtRiskFiles:       []string{"services/billing/invoice.go", "services/billing/payment.go"},
+				AtRiskDirectories: []string{"services", "services/billing"},
+			},
+		}
+		model := overview.NewOverview(repoDetails)
+
+		defaultTheme := style.NewDefaultTheme()
+
+		primaryColorStyle := lipgloss.NewStyle().Foreground(defaultTheme.General.PrimaryColor)
+		secondaryColorStyle := lipgloss.NewStyle().Foreground(defaultTheme.General.SecondaryColor)
+
+		expectedView := strings.Builder{}
+		expectedView.WriteString(fmt.Sprintf("%s %s %s\n", primaryColorStyle.Render("Bus factor:"),
+			secondaryColorStyle.Render("1"), secondaryColorStyle.Render("gitcha1@gitcha.com")))
+		expectedView.WriteString(fmt.Sprintf("%s %s\n", primaryColorStyle.Render("At risk:"),
+			secondaryColorStyle.Render("2 of 3 files")))
+		for _, directory := range repoDetails.BusFactor.AtRiskDirectories {
+			expectedView.WriteString(fmt.Sprintf("%s %s\n", primaryColorStyle.Render("At risk directory:"),
+				secondaryColorStyle.Render(directory)))
+		}
+
+		actual := model.View()
+
+		assert.Contains(t, actual, expectedView.String())
+	})
+	t.Run("given ownership should return top 3 owners by surviving lines in view", func(t *testing.T) {
+		t.Parallel()
+
+		owners := []reporeader.AuthorOwnership{
+			{Email: "gitcha1@gitcha.com", Lines: 50, Percent: 50},
+			{Email: "gitcha2@gitcha.com", Lines: 25, Percent: 25},
+			{Email: "gitcha3@gitcha.com", Lines: 15, Percent: 15},
+			{Email: "gitcha4@gitcha.com", Lines: 10, Percent: 10},
+		}
+		repoDetails := reporeader.RepoDetails{
+			Ownership: reporeader.Ownership{Repository: reporeader.PathOwnership{Lines: 100, Owners: owners}},
+		}
+		model := overview.NewOverview(repoDetails)
+
+		defaultTheme := style.NewDefaultTheme()
+
+		primaryColorStyle := lipgloss.NewStyle().Foreground(defaultTheme.General.PrimaryColor)
+		secondaryColorStyle := lipgloss.NewStyle().Foreground(defaultTheme.General.SecondaryColor)
+
+		expectedView := strings.Builder{}
+		for i := 0; i < 3; i++ {
+			expectedView.WriteString(fmt.Sprintf("%s %s %s %s\n",
+				primaryColorStyle.Render("Owner:"),
+				secondaryColorStyle.Render(owners[i].Email),
+				secondaryColorStyle.Render(fmt.Sprintf("%.1f%%", owners[i].Percent)),
+				secondaryColorStyle.Render(fmt.Sprintf("%d lines", owners[i].Lines))))
+		}
+
+		actual := model.View()
+
+		assert.Contains(t, actual, expectedView.String())
+		assert.NotContains(t, actual, owners[3].Email)
+	})
+	t.Run("given commits credited through co-author trailers should return co-author name in view", func(t *testing.T) {
+		t.Parallel()
+
+		author := reporeader.Author{Name: "Gitcha One", Email: "gitcha1@gitcha.com"}
+		coAuthor := reporeader.Author{Name: "Gitcha Two", Email: "gitcha2@gitcha.com"}
+		commit := reporeader.Commit{Author: author, CoAuthors: []reporeader.Author{coAuthor}, Hash: "someHash"}
+
+		authorCommits := map[string][]reporeader.Commit{
+			author.Email:   {commit},
+			coAuthor.Email: {commit},
+		}
+		model := overview.NewOverview(reporeader.RepoDetails{AuthorsCommits: authorCommits})
+
+		defaultTheme := style.NewDefaultTheme()
+		secondaryColorStyle := lipgloss.NewStyle().Foreground(defaultTheme.General.SecondaryColor)
+
+		actual := model.View()
+
+		assert.Contains(t, actual, secondaryColorStyle.Render(coAuthor.Name)+" "+secondaryColorStyle.Render(coAuthor.Email))
+	})
+	t.Run("given commits with co-authors credited to their author alone should return co-author counts in view", func(t *testing.T) {
+		t.Parallel()
+
+		author := reporeader.Author{Name: "Gitcha One", Email: "gitcha1@gitcha.com"}
+		coAuthor := reporeader.Author{Name: "Gitcha Two", Email: "gitcha2@gitcha.com"}
+		pairs := []reporeader.Commit{
+			{Author: author, CoAuthors: []reporeader.Author{coAuthor}, Hash: "firstHash"},
+			{Author: author, CoAuthors: []reporeader.Author{coAuthor}, Hash: "secondHash"},
+		}
+		authorCommits := map[string][]reporeader.Commit{
+			author.Email:   append(pairs, reporeader.Commit{Author: author, Hash: "thirdHash"}),
+			coAuthor.Email: {{Author: coAuthor, CoAuthors: []reporeader.Author{author}, Hash: "fourthHash"}},
+		}
+		model := overview.NewOverview(reporeader.RepoDetails{AuthorsCommits: authorCommits})
+
+		defaultTheme := style.NewDefaultTheme()
+		primaryColorStyle := lipgloss.NewStyle().Foreground(defaultTheme.General.PrimaryColor)
+		secondaryColorStyle := lipgloss.NewStyle().Foreground(defaultTheme.General.SecondaryColor)
+
+		actual := model.View()
+
+		assert.Contains(t, actual, secondaryColorStyle.Render("3")+" "+secondaryColorStyle.Render("(1 co-authored)"))
+		assert.Contains(t, actual, secondaryColorStyle.Render("1")+" "+secondaryColorStyle.Render("(2 co-authored)"))
+		assert.Contains(t, actual, fmt.Sprintf("%s %s %s %s\n", primaryColorStyle.Render("Co-author:"),
+			secondaryColorStyle.Render(coAuthor.Name), secondaryColorStyle.Render(coAuthor.Email), secondaryColorStyle.Render("2")))
+	})
+
+	t.Run("given bot commits should return bots apart from the authors in view", func(t *testing.T) {
+		t.Parallel()
+
+		bot := reporeader.Author{Name: "dependabot[bot]", Email: "dependabot[bot]@users.noreply.github.com"}
+		repoDetails := reporeader.RepoDetails{
+			BotCommits: map[string][]reporeader.Commit{bot.Email: {{Author: bot, Hash: "someHash"}}},
+		}
+		model := overview.NewOverview(repoDetails)
+
+		defaultTheme := style.NewDefaultTheme()
+		primaryColorStyle := lipgloss.NewStyle().Foreground(defaultTheme.General.PrimaryColor)
+		secondaryColorStyle := lipgloss.NewStyle().Foreground(defaultTheme.General.SecondaryColor)
+
+		expected := fmt.Sprintf("%s %s %s %s\n", primaryColorStyle.Render("Bot:"), secondaryColorStyle.Render(bot.Name),
+			secondaryColorStyle.Render(bot.Email), secondaryColorStyle.Render("1"))
+
+		actual := model.View()
+
+		assert.Contains(t, actual, expected)
+	})
+	t.Run("given conventions should return adherence, top types and scopes and monthly adherence in view", func(t *testing.T) {
+		t.Parallel()
+
+		repoDetails := reporeader.RepoDetails{
+			Conventions: reporeader.Conventions{
+				Repository: reporeader.ConventionBreakdown{
+					Commits:    4,
+					Conforming: 3,
+					Breaking:   1,
+					Types:      map[string]int{"feat": 2, "fix": 1},
+					Scopes:     map[string]int{"parser": 2},
+				},
+				Months: map[string]reporeader.ConventionBreakdown{
+					"2023-01": {Commits: 2, Conforming: 1},
+					"2023-02": {Commits: 2, Conforming: 2},
+				},
+			},
+		}
+		model := overview.NewOverview(repoDetails)
+
+		actual := model.View()
+
+		assert.Contains(t, actual, "3 of 4 (25.0% non-conforming), 1 breaking")
+		assert.Contains(t, actual, "feat (2) fix (1)")
+		assert.Contains(t, actual, "parser (2)")
+		assert.Contains(t, actual, "2023-01 50% 2023-02 100%")
+	})
+	t.Run("given releases should return release cadence in view", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+		repoDetails := reporeader.RepoDetails{
+			Releases: reporeader.Releases{
+				Releases: []reporeader.Release{
+					{Tag: reporeader.Tag{Name: "v0.1.0", Date: start}},
+					{Tag: reporeader.Tag{Name: "v0.2.0", Date: start.Add(36 * time.Hour)}},
+				},
+				AverageInterval: 36 * time.Hour,
+			},
+		}
+		model := overview.NewOverview(repoDetails)
+
+		actual := model.View()
+
+		assert.Contains(t, actual, "2, latest v0.2.0 2023-01-03, every 1.5 days on average")
+	})
+
+	t.Run("given versions should return latest versions and bad tags in view", func(t *testing.T) {
+		t.Parallel()
+
+		repoDetails := reporeader.RepoDetails{
+			Releases: reporeader.Releases{Releases: []reporeader.Release{{Tag: reporeader.Tag{Name: "v2.0.0"}}}},
+			Versions: reporeader.Versions{
+				Malformed:        []string{"v2.0"},
+				OutOfOrder:       []string{"v1.1.1"},
+				LatestStable:     "v2.0.0",
+				LatestPreRelease: "v2.1.0-rc.1",
+			},
+		}
+		model := overview.NewOverview(repoDetails)
+
+		actual := model.View()
+
+		assert.Contains(t, actual, "stable v2.0.0, pre-release v2.1.0-rc.1")
+		assert.Contains(t, actual, "Malformed tags:")
+		assert.Contains(t, actual, "Out of order tags:")
+	})
+
+	t.Run("given status should return branch, upstream and worktree changes in view", func(t *testing.T) {
+		t.Parallel()
+
+		repoDetails := reporeader.RepoDetails{
+			Status: reporeader.Status{
+				Branch:    "main",
+				Head:      "0123456789abcdef0123456789abcdef01234567",
+				Upstream:  "origin/main",
+				Staged:    1,
+				Modified:  2,
+				Untracked: 3,
+			},
+		}
+		model := overview.NewOverview(repoDetails)
+
+		actual := model.View()
+
+		assert.Contains(t, actual, "main tracking origin/main")
+		assert.Contains(t, actual, "1 staged, 2 modified, 3 untracked, 0 conflicted")
+	})
+
+	t.Run("given detached HEAD and clean worktree should return short hash and clean in view", func(t *testing.T) {
+		t.Parallel()
+
+		repoDetails := reporeader.RepoDetails{
+			Status: reporeader.Status{Detached: true, Head: "0123456789abcdef0123456789abcdef01234567"},
+		}
+		model := overview.NewOverview(repoDetails)
+
+		actual := model.View()
+
+		assert.Contains(t, actual, "detached HEAD at 0123456")
+		assert.Contains(t, actual, "clean")
+	})
+
+	t.Run("given remotes should return project web URL and remotes in view", func(t *testing.T) {
+		t.Parallel()
+
+		project := hosting.Project{Provider: hosting.GitHub, Host: "github.com", Owner: "djyuhn", Name: "gitcha"}
+		repoDetails := reporeader.RepoDetails{
+			Remotes: reporeader.Remotes{Remotes: []reporeader.Remote{
+				{Name: "origin", URLs: []string{"git@github.com:djyuhn/gitcha.git"}, Project: &project},
+				{Name: "local", URLs: []string{"/srv/git/gitcha"}},
+			}},
+		}
+		model := overview.NewOverview(repoDetails)
+
+		actual := model.View()
+
+		assert.Contains(t, actual, "https://github.com/djyuhn/gitcha (GitHub)")
+		assert.Contains(t, actual, "origin git@github.com:djyuhn/gitcha.git")
+		assert.Contains(t, actual, "local /srv/git/gitcha")
+	})
+
+	t.Run("given merged pull requests should return counts, lead time, authors and weekly merges in view", func(t *testing.T) {
+		t.Parallel()
+
+		repoDetails := reporeader.RepoDetails{
+			Merges: reporeader.Merges{
+				MergeCommits:    2,
+				PullRequests:    []reporeader.PullRequest{{Number: 1}, {Number: 2}, {Number: 3, Squashed: true}},
+				Authors:         map[string]int{"gitcha1@gitcha.com": 1, "gitcha2@gitcha.com": 2},
+				Weekly:          map[string]int{"2023-01-02": 1, "2023-01-09": 2},
+				AverageLeadTime: 36 * time.Hour,
+				MedianLeadTime:  36 * time.Hour,
+			},
+		}
+		model := overview.NewOverview(repoDetails)
+
+		actual := model.View()
+
+		assert.Contains(t, actual, "3 merged (1 squashed), 2 merge commits, 1.5 days from first commit on average (median 1.5 days)")
+		assert.Contains(t, actual, "gitcha2@gitcha.com (2) gitcha1@gitcha.com (1)")
+		assert.Contains(t, actual, "2023-01-02 1 2023-01-09 2")
+	})
+
+	t.Run("given reverts should return revert and fix shares and most reverted files and authors in view", func(t *testing.T) {
+		t.Parallel()
+
+		repoDetails := reporeader.RepoDetails{
+			Reverts: reporeader.Reverts{
+				Commits: 8,
+				Fixes:   2,
+				Reverts: []reporeader.Revert{{Hash: "a"}, {Hash: "b"}},
+				Files:   map[string]int{"main.go": 2, "README.md": 1},
+				Authors: map[string]int{"gitcha1@gitcha.com": 2},
+			},
+		}
+		model := overview.NewOverview(repoDetails)
+
+		actual := model.View()
+
+		assert.Contains(t, actual, "2 of 8 commits (25.0%), 2 fixes (25.0%)")
+		assert.Contains(t, actual, "main.go (2) README.md (1)")
+		assert.Contains(t, actual, "gitcha1@gitcha.com (2)")
+	})
+
+	t.Run("given failed analyses should list them with their errors in view", func(t *testing.T) {
+		t.Parallel()
+
+		repoDetails := reporeader.RepoDetails{
+			Errors: map[string]string{
+				"size":     "getRepoSize: unable to list the objects",
+				"branches": "getBranches: unable to find commit",
+			},
+		}
+		model := overview.NewOverview(repoDetails)
+
+		actual := model.View()
+
+		assert.Contains(t, actual, "Unable to analyze:")
+		assert.Regexp(t, `(?s)branches: getBranches: unable to find commit.*size: getRepoSize: unable to list the objects`, actual)
+	})
+
+	t.Run("given deferred authorship should leave out the bus factor and owners and list the deferred analysis in view", func(t *testing.T) {
+		t.Parallel()
+
+		repoDetails := reporeader.RepoDetails{
+			Ownership: reporeader.Ownership{
+				Repository: reporeader.PathOwnership{Owners: []reporeader.AuthorOwnership{{Email: "gitcha1@gitcha.com"}}},
+			},
+			Deferred: []reporeader.Analysis{reporeader.AnalysisAuthorship},
+		}
+		model := overview.NewOverview(repoDetails)
+
+		actual := model.View()
+
+		assert.NotContains(t, actual, "Bus factor:")
+		assert.NotContains(t, actual, "Owner:")
+		assert.Contains(t, actual, "Not analyzed yet:")
+		assert.Contains(t, actual, "authorship")
+	})
+
+	t.Run("given issue references should return the reference share and lowest reference rates in view", func(t *testing.T) {
+		t.Parallel()
+
+		repoDetails := reporeader.RepoDetails{
+			Issues: reporeader.IssueReferences{
+				ReferenceRate: reporeader.ReferenceRate{Commits: 4, Referencing: 3},
+				Issues:        map[string][]string{"#12": {"a", "b"}, "GITCHA-7": {"c"}},
+				Unreferenced:  []reporeader.Commit{{Hash: "d"}},
+				Authors: map[string]reporeader.ReferenceRate{
+					"gitcha1@gitcha.com": {Commits: 2, Referencing: 2},
+					"gitcha2@gitcha.com": {Commits: 2, Referencing: 1},
+				},
+			},
+		}
+		model := overview.NewOverview(repoDetails)
+
+		actual := model.View()
+
+		assert.Contains(t, actual, "3 of 4 commits (75.0%), 2 issues, 1 commits without a reference")
+		assert.Contains(t, actual, "gitcha2@gitcha.com (50.0%) gitcha1@gitcha.com (100.0%)")
+	})
+
+	t.Run("given signatures should return signing shares and unsigned commits of protected branches in view", func(t *testing.T) {
+		t.Parallel()
+
+		repoDetails := reporeader.RepoDetails{
+			Signatures: reporeader.Signatures{
+				SigningRate: reporeader.SigningRate{Commits: 4, Signed: 3, Verified: 2},
+				Checked:     true,
+				Types:       map[reporeader.SignatureType]int{reporeader.SignatureGPG: 2, reporeader.SignatureSSH: 1, reporeader.SignatureNone: 1},
+				Authors: map[string]reporeader.SigningRate{
+					"gitcha1@gitcha.com": {Commits: 2, Signed: 2, Verified: 1},
+					"gitcha2@gitcha.com": {Commits: 2, Signed: 1, Verified: 1},
+				},
+				Protected: []reporeader.ProtectedBranch{{
+					Name:       "main",
+					Commits:    4,
+					Unsigned:   []reporeader.CommitSignature{{Hash: "a"}},
+					Unverified: []reporeader.CommitSignature{{Hash: "b"}},
+				}},
+			},
+		}
+		model := overview.NewOverview(repoDetails)
+
+		actual := model.View()
+
+		assert.Contains(t, actual, "3 of 4 commits (75.0%), gpg 2 ssh 1, 2 verified (50.0%)")
+		assert.Contains(t, actual, "gitcha2@gitcha.com (50.0%) gitcha1@gitcha.com (100.0%)")
+		assert.Contains(t, actual, "Unsigned on main:")
+		assert.Contains(t, actual, "1 of 4 commits, 1 not verified")
+	})
+
+	t.Run("given a repository size should return the disk size and largest blobs in view", func(t *testing.T) {
+		t.Parallel()
+
+		repoDetails := reporeader.RepoDetails{
+			Size: reporeader.RepoSize{
+				Loose:        reporeader.ObjectStats{Count: 12, Size: 2048},
+				Packs:        reporeader.ObjectStats{Count: 1, Size: 3 * 1024 * 1024},
+				HeadBlobs:    reporeader.ObjectStats{Count: 3, Size: 1536},
+				HistoryBlobs: reporeader.ObjectStats{Count: 2, Size: 5 * 1024 * 1024},
+				LargestBlobs: []reporeader.LargeBlob{
+					{Path: "assets/video.mp4", Size: 4 * 1024 * 1024},
+					{Path: "assets/logo.png", Size: 1024 * 1024, AtHead: true},
+					{Path: "README.md", Size: 512, AtHead: true},
+					{Path: "main.go", Size: 100, AtHead: true},
+				},
+			},
+		}
+		model := overview.NewOverview(repoDetails)
+
+		actual := model.View()
+
+		assert.Contains(t, actual, "3.0 MiB on disk, 1 packs, 12 loose objects")
+		assert.Contains(t, actual, "3 at HEAD (1.5 KiB), 2 only in history (5.0 MiB)")
+		assert.Contains(t, actual, "assets/video.mp4 (4.0 MiB, removed) assets/logo.png (1.0 MiB) README.md (512 B)")
+		assert.NotContains(t, actual, "main.go")
+	})
 }
 
 func getSortedAuthorsByCommitCount(authorCommits map[string][]reporeader.Commit) []overview.AuthorCommitsPair {
@@ -222,8 +747,9 @@ func getSortedAuthorsByCommitCount(authorCommits map[string][]reporeader.Commit)
 		if len(commits) == 0 {
 			continue
 		}
+		author, _ := commits[0].Contributor(email)
 		pair := overview.AuthorCommitsPair{
-			AuthorName:  commits[0].Author.Name,
+			AuthorName:  author.Name,
 			AuthorEmail: email,
 			Commits:     commits,
 		}
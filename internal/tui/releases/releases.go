@@ -0,0 +1,182 @@
+package releases
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/djyuhn/gitcha/internal/reporeader"
+	"github.com/djyuhn/gitcha/internal/tui/style"
+)
+
+const (
+	day = 24 * time.Hour
+
+	tagColumnWidth    = 30
+	dateColumnWidth   = 12
+	countColumnWidth  = 14
+	flagColumnWidth   = 14
+	defaultViewHeight = 20
+
+	// headerHeight is the number of lines rendered above the table rows.
+	headerHeight = 4
+)
+
+// Releases is a timeline of the releases of the repository, newest first, with the cadence between them and the
+// tags that are not valid or ordered semantic versions flagged.
+type Releases struct {
+	Releases reporeader.Releases
+	Versions reporeader.Versions
+
+	theme style.Theme
+	table table.Model
+}
+
+var _ tea.Model = Releases{}
+
+func NewReleases(repoDetails reporeader.RepoDetails) Releases {
+	defaultTheme := style.NewDefaultTheme()
+
+	styles := table.DefaultStyles()
+	styles.Header = styles.Header.Foreground(defaultTheme.General.PrimaryColor)
+	styles.Selected = styles.Selected.Foreground(defaultTheme.General.SecondaryColor)
+
+	flags := make(map[string]string)
+	for _, name := range repoDetails.Versions.Malformed {
+		flags[name] = "malformed"
+	}
+	for _, name := range repoDetails.Versions.OutOfOrder {
+		flags[name] = "out of order"
+	}
+
+	releases := repoDetails.Releases.Releases
+	rows := make([]table.Row, 0, len(releases))
+	for i := len(releases) - 1; i >= 0; i-- {
+		release := releases[i]
+
+		since := "-"
+		if release.Previous != "" {
+			since = formatDays(release.SincePrevious)
+		}
+
+		rows = append(rows, table.Row{
+			release.Tag.Name,
+			release.Tag.Date.Format("2006-01-02"),
+			since,
+			strconv.Itoa(release.Commits),
+			strconv.Itoa(len(release.Contributors)),
+			flags[release.Tag.Name],
+		})
+	}
+
+	t := table.New(
+		table.WithColumns([]table.Column{
+			{Title: "Tag", Width: tagColumnWidth},
+			{Title: "Date", Width: dateColumnWidth},
+			{Title: "Since previous", Width: countColumnWidth},
+			{Title: "Commits", Width: countColumnWidth},
+			{Title: "Contributors", Width: countColumnWidth},
+			{Title: "Flag", Width: flagColumnWidth},
+		}),
+		table.WithRows(rows),
+		table.WithHeight(defaultViewHeight),
+		table.WithFocused(true),
+		table.WithStyles(styles),
+	)
+
+	return Releases{Releases: repoDetails.Releases, Versions: repoDetails.Versions, theme: *defaultTheme, table: t}
+}
+
+func (r Releases) Init() tea.Cmd {
+	return nil
+}
+
+func (r Releases) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(tea.WindowSizeMsg); ok {
+		r.SetHeight(msg.Height)
+		return r, nil
+	}
+
+	var cmd tea.Cmd
+	r.table, cmd = r.table.Update(msg)
+
+	return r, cmd
+}
+
+func (r Releases) View() string {
+	primaryColorStyle := lipgloss.NewStyle().Foreground(r.theme.General.PrimaryColor)
+	secondaryColorStyle := lipgloss.NewStyle().Foreground(r.theme.General.SecondaryColor)
+
+	label := primaryColorStyle.Render("Releases:")
+
+	releases := r.Releases.Releases
+	if len(releases) == 0 {
+		return fmt.Sprintf("%s %s", label, secondaryColorStyle.Render("no tags"))
+	}
+
+	cadence := fmt.Sprintf("%d, latest %s", len(releases), releases[len(releases)-1].Tag.Name)
+	if len(releases) > 1 {
+		cadence += fmt.Sprintf(", every %s on average (median %s)",
+			formatDays(r.Releases.AverageInterval), formatDays(r.Releases.MedianInterval))
+	}
+
+	versionLabel := primaryColorStyle.Render("Versions:")
+	versions := secondaryColorStyle.Render(formatVersions(r.Versions))
+
+	return fmt.Sprintf("%s %s\n%s %s\n\n%s", label, secondaryColorStyle.Render(cadence), versionLabel, versions, r.table.View())
+}
+
+// FormatVersions summarizes the latest stable and pre-release versions and the number of releases of each kind.
+func formatVersions(versions reporeader.Versions) string {
+	latest := make([]string, 0)
+	if versions.LatestStable != "" {
+		latest = append(latest, "latest stable "+versions.LatestStable)
+	}
+	if versions.LatestPreRelease != "" {
+		latest = append(latest, "latest pre-release "+versions.LatestPreRelease)
+	}
+	if len(latest) == 0 {
+		latest = append(latest, "no semantic versions")
+	}
+
+	return fmt.Sprintf("%s, %d major, %d minor, %d patch, %d pre-release", strings.Join(latest, ", "),
+		versions.MajorReleases, versions.MinorReleases, versions.PatchReleases, versions.PreReleases)
+}
+
+// SetHeight sets the height available to the view, including its header.
+func (r *Releases) SetHeight(height int) {
+	if height > headerHeight {
+		r.table.SetHeight(height - headerHeight)
+	}
+}
+
+// SelectedTag returns the name of the selected tag, if any.
+func (r Releases) SelectedTag() (string, bool) {
+	if len(r.table.Rows()) == 0 {
+		return "", false
+	}
+
+	return r.table.SelectedRow()[0], true
+}
+
+// Selected returns the selected release, if any.
+func (r Releases) Selected() (reporeader.Release, bool) {
+	releases := r.Releases.Releases
+	cursor := r.table.Cursor()
+	if cursor < 0 || cursor >= len(releases) {
+		return reporeader.Release{}, false
+	}
+
+	// The rows list the releases newest first.
+	return releases[len(releases)-1-cursor], true
+}
+
+// formatDays formats a duration as a number of days with one decimal.
+func formatDays(duration time.Duration) string {
+	return fmt.Sprintf("%.1f days", float64(duration)/float64(day))
+}
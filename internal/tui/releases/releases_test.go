@@ -0,0 +1,141 @@
+package releases_test
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/djyuhn/gitcha/internal/reporeader"
+	"github.com/djyuhn/gitcha/internal/tui/releases"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReleases(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return releases model with the newest release selected", func(t *testing.T) {
+		t.Parallel()
+
+		repoDetails := reporeader.RepoDetails{Releases: repoReleases()}
+
+		actual := releases.NewReleases(repoDetails)
+
+		assert.Equal(t, repoDetails.Releases, actual.Releases)
+		tag, ok := actual.SelectedTag()
+		assert.True(t, ok)
+		assert.Equal(t, "v0.2.0", tag)
+	})
+}
+
+func TestReleases_Init(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		model := releases.NewReleases(reporeader.RepoDetails{})
+
+		assert.Nil(t, model.Init())
+	})
+}
+
+func TestReleases_Update(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given down key should select the previous release", func(t *testing.T) {
+		t.Parallel()
+
+		model := releases.NewReleases(reporeader.RepoDetails{Releases: repoReleases()})
+
+		updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+		actual, ok := updated.(releases.Releases)
+		require.True(t, ok)
+
+		tag, ok := actual.SelectedTag()
+		assert.True(t, ok)
+		assert.Equal(t, "v0.1.0", tag)
+
+		release, ok := actual.Selected()
+		assert.True(t, ok)
+		assert.Equal(t, "v0.1.0", release.Tag.Name)
+	})
+}
+
+func TestReleases_View(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given releases should show cadence and timeline", func(t *testing.T) {
+		t.Parallel()
+
+		model := releases.NewReleases(reporeader.RepoDetails{Releases: repoReleases()})
+
+		actual := model.View()
+
+		assert.Contains(t, actual, "2, latest v0.2.0, every 3.0 days on average (median 3.0 days)")
+		assert.Contains(t, actual, "v0.1.0")
+		assert.Contains(t, actual, "2023-01-05")
+	})
+
+	t.Run("given versions should show latest versions and flag bad tags", func(t *testing.T) {
+		t.Parallel()
+
+		repoDetails := reporeader.RepoDetails{
+			Releases: repoReleases(),
+			Versions: reporeader.Versions{
+				OutOfOrder:    []string{"v0.1.0"},
+				MinorReleases: 2,
+				LatestStable:  "v0.2.0",
+			},
+		}
+		model := releases.NewReleases(repoDetails)
+
+		actual := model.View()
+
+		assert.Contains(t, actual, "latest stable v0.2.0, 0 major, 2 minor, 0 patch, 0 pre-release")
+		assert.Contains(t, actual, "out of order")
+	})
+
+	t.Run("given no releases should show no tags", func(t *testing.T) {
+		t.Parallel()
+
+		model := releases.NewReleases(reporeader.RepoDetails{})
+
+		assert.Contains(t, model.View(), "no tags")
+	})
+
+	t.Run("given no releases should not select a tag", func(t *testing.T) {
+		t.Parallel()
+
+		model := releases.NewReleases(reporeader.RepoDetails{})
+
+		_, ok := model.SelectedTag()
+		assert.False(t, ok)
+	})
+}
+
+func repoReleases() reporeader.Releases {
+	start := time.Date(2023, time.January, 2, 9, 0, 0, 0, time.UTC)
+
+	return reporeader.Releases{
+		Releases: []reporeader.Release{
+			{
+				Tag:          reporeader.Tag{Name: "v0.1.0", Date: start},
+				Commits:      2,
+				Contributors: []string{"gitcha1@gitcha.com"},
+			},
+			{
+				Tag:           reporeader.Tag{Name: "v0.2.0", Date: start.Add(72 * time.Hour)},
+				Previous:      "v0.1.0",
+				SincePrevious: 72 * time.Hour,
+				Commits:       3,
+				Contributors:  []string{"gitcha1@gitcha.com", "gitcha2@gitcha.com"},
+			},
+		},
+		AverageInterval: 72 * time.Hour,
+		MedianInterval:  72 * time.Hour,
+	}
+}
@@ -0,0 +1,285 @@
+package tree
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/djyuhn/gitcha/internal/reporeader"
+	"github.com/djyuhn/gitcha/internal/tui/style"
+)
+
+const (
+	nameColumnWidth   = 40
+	countColumnWidth  = 8
+	indentWidth       = 2
+	topReviewerCount  = 3
+	defaultViewHeight = 20
+
+	// headerHeight is the number of lines rendered above the rows and detailsHeight the number of lines rendered
+	// below them for the selected node.
+	headerHeight  = 3
+	detailsHeight = 4 + topReviewerCount
+)
+
+// Row is a node of the tree visible in the view along with its depth below the root.
+type Row struct {
+	Node  reporeader.TreeNode
+	Depth int
+}
+
+// Tree is a file-tree explorer of the analyzed revision. Directories can be expanded down to individual files and the
+// aggregated stats and top contributors of the selected node are shown below the tree.
+type Tree struct {
+	Root     reporeader.TreeNode
+	Expanded map[string]bool
+	Cursor   int
+
+	theme  style.Theme
+	height int
+	offset int
+}
+
+var _ tea.Model = Tree{}
+
+func NewTree(repoDetails reporeader.RepoDetails) Tree {
+	defaultTheme := style.NewDefaultTheme()
+
+	return Tree{
+		Root:     repoDetails.Tree,
+		Expanded: make(map[string]bool),
+		theme:    *defaultTheme,
+		height:   defaultViewHeight,
+	}
+}
+
+func (t Tree) Init() tea.Cmd {
+	return nil
+}
+
+func (t Tree) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		rows := t.Rows()
+
+		switch msg.String() {
+		case "up", "k":
+			t.moveCursor(t.Cursor - 1)
+		case "down", "j":
+			t.moveCursor(t.Cursor + 1)
+		case "enter", " ":
+			if t.Cursor < len(rows) && rows[t.Cursor].Node.IsDir {
+				path := rows[t.Cursor].Node.Path
+				t.setExpanded(path, !t.Expanded[path])
+			}
+		case "right", "l":
+			if t.Cursor < len(rows) && rows[t.Cursor].Node.IsDir {
+				t.setExpanded(rows[t.Cursor].Node.Path, true)
+			}
+		case "left", "h":
+			t.collapseOrSelectParent(rows)
+		}
+	case tea.WindowSizeMsg:
+		t.SetHeight(msg.Height)
+	}
+
+	return t, nil
+}
+
+func (t Tree) View() string {
+	primaryColorStyle := lipgloss.NewStyle().Foreground(t.theme.General.PrimaryColor)
+	secondaryColorStyle := lipgloss.NewStyle().Foreground(t.theme.General.SecondaryColor)
+	selectedStyle := secondaryColorStyle.Bold(true)
+
+	view := strings.Builder{}
+
+	label := primaryColorStyle.Render("Tree:")
+	help := secondaryColorStyle.Render("enter to expand or collapse, arrows to navigate")
+	view.WriteString(fmt.Sprintf("%s %s\n\n", label, help))
+	view.WriteString(primaryColorStyle.Render(fmt.Sprintf("%-*s %*s %*s %*s",
+		nameColumnWidth, "Name", countColumnWidth, "Files", countColumnWidth, "Lines", countColumnWidth, "Commits")) + "\n")
+
+	rows := t.Rows()
+	end := t.offset + t.visibleRowCount()
+	if end > len(rows) {
+		end = len(rows)
+	}
+
+	for i := t.offset; i < end; i++ {
+		line := formatRow(rows[i], t.Expanded[rows[i].Node.Path])
+		if i == t.Cursor {
+			view.WriteString(selectedStyle.Render(line) + "\n")
+			continue
+		}
+		view.WriteString(line + "\n")
+	}
+
+	if node, ok := t.Selected(); ok {
+		view.WriteString("\n" + t.buildDetailsView(node))
+	}
+
+	return view.String()
+}
+
+// SetHeight sets the height available to the view, including its header and the details of the selected node.
+func (t *Tree) SetHeight(height int) {
+	t.height = height
+	t.moveCursor(t.Cursor)
+}
+
+// Rows returns the nodes visible with the current expanded directories, in the order they are displayed.
+func (t Tree) Rows() []Row {
+	rows := make([]Row, 0)
+
+	var appendRows func(nodes []reporeader.TreeNode, depth int)
+	appendRows = func(nodes []reporeader.TreeNode, depth int) {
+		for _, node := range nodes {
+			rows = append(rows, Row{Node: node, Depth: depth})
+			if node.IsDir && t.Expanded[node.Path] {
+				appendRows(node.Children, depth+1)
+			}
+		}
+	}
+	appendRows(t.Root.Children, 0)
+
+	return rows
+}
+
+// Selected returns the node under the cursor, if any.
+func (t Tree) Selected() (reporeader.TreeNode, bool) {
+	rows := t.Rows()
+	if t.Cursor >= len(rows) {
+		return reporeader.TreeNode{}, false
+	}
+
+	return rows[t.Cursor].Node, true
+}
+
+// SelectedPath returns the path of the node under the cursor, if any.
+func (t Tree) SelectedPath() (string, bool) {
+	node, ok := t.Selected()
+	if !ok {
+		return "", false
+	}
+
+	return node.Path, true
+}
+
+// setExpanded expands or collapses the directory at path. Expanded is copied first since models are passed by value
+// and must not share state with previous versions.
+func (t *Tree) setExpanded(path string, expanded bool) {
+	updated := make(map[string]bool, len(t.Expanded)+1)
+	for p, e := range t.Expanded {
+		updated[p] = e
+	}
+
+	if expanded {
+		updated[path] = true
+	} else {
+		delete(updated, path)
+	}
+	t.Expanded = updated
+}
+
+// collapseOrSelectParent collapses the selected directory if it is expanded, otherwise it moves the cursor to the
+// parent directory of the selected node.
+func (t *Tree) collapseOrSelectParent(rows []Row) {
+	if t.Cursor >= len(rows) {
+		return
+	}
+
+	row := rows[t.Cursor]
+	if row.Node.IsDir && t.Expanded[row.Node.Path] {
+		t.setExpanded(row.Node.Path, false)
+		return
+	}
+
+	for i := t.Cursor - 1; i >= 0; i-- {
+		if rows[i].Depth < row.Depth {
+			t.moveCursor(i)
+			return
+		}
+	}
+}
+
+// moveCursor moves the cursor to index, within the visible rows, and scrolls so that it stays in view.
+func (t *Tree) moveCursor(index int) {
+	count := len(t.Rows())
+	if index >= count {
+		index = count - 1
+	}
+	if index < 0 {
+		index = 0
+	}
+	t.Cursor = index
+
+	visible := t.visibleRowCount()
+	if t.Cursor < t.offset {
+		t.offset = t.Cursor
+	}
+	if t.Cursor >= t.offset+visible {
+		t.offset = t.Cursor - visible + 1
+	}
+}
+
+// visibleRowCount returns the number of rows that fit in the view.
+func (t Tree) visibleRowCount() int {
+	count := t.height - headerHeight - detailsHeight
+	if count < 1 {
+		return 1
+	}
+
+	return count
+}
+
+// buildDetailsView renders the aggregated stats of node and the contributors best placed to review changes to it.
+func (t Tree) buildDetailsView(node reporeader.TreeNode) string {
+	primaryColorStyle := lipgloss.NewStyle().Foreground(t.theme.General.PrimaryColor)
+	secondaryColorStyle := lipgloss.NewStyle().Foreground(t.theme.General.SecondaryColor)
+
+	view := strings.Builder{}
+
+	view.WriteString(fmt.Sprintf("%s %s\n", primaryColorStyle.Render("Path:"), secondaryColorStyle.Render(node.Path)))
+
+	lastChange := "never"
+	if !node.LastChange.IsZero() {
+		lastChange = node.LastChange.Format("2006-01-02")
+	}
+	stats := fmt.Sprintf("%d files, %d lines, %d commits, last changed %s", node.Files, node.Lines, node.Commits, lastChange)
+	view.WriteString(fmt.Sprintf("%s %s\n", primaryColorStyle.Render("Stats:"), secondaryColorStyle.Render(stats)))
+
+	view.WriteString(primaryColorStyle.Render("Top contributors:") + "\n")
+	for i, contributor := range node.Contributors {
+		if i >= topReviewerCount {
+			break
+		}
+		view.WriteString(secondaryColorStyle.Render(fmt.Sprintf("  %s %.1f%% %d lines",
+			contributor.Email, contributor.Percent, contributor.Lines)) + "\n")
+	}
+
+	return view.String()
+}
+
+// formatRow renders row as an indented name followed by its file, line and commit counts.
+func formatRow(row Row, expanded bool) string {
+	name := row.Node.Name
+	marker := "  "
+	if row.Node.IsDir {
+		name += "/"
+		marker = "▸ "
+		if expanded {
+			marker = "▾ "
+		}
+	}
+
+	name = strings.Repeat(" ", row.Depth*indentWidth) + marker + name
+	if len([]rune(name)) > nameColumnWidth {
+		name = string([]rune(name)[:nameColumnWidth-1]) + "…"
+	}
+
+	return fmt.Sprintf("%-*s %*d %*d %*d",
+		nameColumnWidth, name, countColumnWidth, row.Node.Files, countColumnWidth, row.Node.Lines,
+		countColumnWidth, row.Node.Commits)
+}
@@ -0,0 +1,188 @@
+package tree_test
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/djyuhn/gitcha/internal/reporeader"
+	"github.com/djyuhn/gitcha/internal/tui/tree"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTree(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return tree model with the repository tree collapsed", func(t *testing.T) {
+		t.Parallel()
+
+		repoDetails := reporeader.RepoDetails{Tree: rootNode()}
+
+		actual := tree.NewTree(repoDetails)
+
+		assert.Equal(t, repoDetails.Tree, actual.Root)
+		assert.Empty(t, actual.Expanded)
+		assert.Equal(t, 0, actual.Cursor)
+	})
+}
+
+func TestTree_Init(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		model := tree.NewTree(reporeader.RepoDetails{})
+
+		assert.Nil(t, model.Init())
+	})
+}
+
+func TestTree_Update(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given enter key on a directory should expand it", func(t *testing.T) {
+		t.Parallel()
+
+		model := tree.NewTree(reporeader.RepoDetails{Tree: rootNode()})
+
+		updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+		actual, ok := updated.(tree.Tree)
+		require.True(t, ok)
+
+		assert.Nil(t, cmd)
+		assert.True(t, actual.Expanded["services"])
+		require.Len(t, actual.Rows(), 4)
+		assert.Equal(t, "services/billing", actual.Rows()[1].Node.Path)
+		assert.Equal(t, 1, actual.Rows()[1].Depth)
+		assert.Empty(t, model.Expanded)
+	})
+
+	t.Run("given enter key on an expanded directory should collapse it", func(t *testing.T) {
+		t.Parallel()
+
+		model := tree.NewTree(reporeader.RepoDetails{Tree: rootNode()})
+		model.Expanded["services"] = true
+
+		updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+		actual, ok := updated.(tree.Tree)
+		require.True(t, ok)
+
+		assert.False(t, actual.Expanded["services"])
+		assert.Len(t, actual.Rows(), 2)
+	})
+
+	t.Run("given down key should move the cursor to the next row", func(t *testing.T) {
+		t.Parallel()
+
+		model := tree.NewTree(reporeader.RepoDetails{Tree: rootNode()})
+
+		updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+		actual, ok := updated.(tree.Tree)
+		require.True(t, ok)
+
+		path, ok := actual.SelectedPath()
+		assert.True(t, ok)
+		assert.Equal(t, "README.md", path)
+	})
+
+	t.Run("given down key on the last row should keep the cursor on the last row", func(t *testing.T) {
+		t.Parallel()
+
+		model := tree.NewTree(reporeader.RepoDetails{Tree: rootNode()})
+		model.Cursor = 1
+
+		updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+		actual, ok := updated.(tree.Tree)
+		require.True(t, ok)
+
+		assert.Equal(t, 1, actual.Cursor)
+	})
+
+	t.Run("given left key on a collapsed node should move the cursor to its parent directory", func(t *testing.T) {
+		t.Parallel()
+
+		model := tree.NewTree(reporeader.RepoDetails{Tree: rootNode()})
+		model.Expanded["services"] = true
+		model.Cursor = 2
+
+		updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyLeft})
+
+		actual, ok := updated.(tree.Tree)
+		require.True(t, ok)
+
+		path, ok := actual.SelectedPath()
+		assert.True(t, ok)
+		assert.Equal(t, "services", path)
+		assert.True(t, actual.Expanded["services"])
+	})
+}
+
+func TestTree_View(t *testing.T) {
+	t.Parallel()
+
+	t.Run("given selected directory should show its stats and top contributors", func(t *testing.T) {
+		t.Parallel()
+
+		model := tree.NewTree(reporeader.RepoDetails{Tree: rootNode()})
+
+		actual := model.View()
+
+		assert.Contains(t, actual, "services/")
+		assert.Contains(t, actual, "README.md")
+		assert.Contains(t, actual, "3 files, 120 lines, 7 commits, last changed 2023-03-04")
+		assert.Contains(t, actual, "billing@gitcha.com 75.0% 90 lines")
+	})
+
+	t.Run("given empty tree should show no selection", func(t *testing.T) {
+		t.Parallel()
+
+		model := tree.NewTree(reporeader.RepoDetails{})
+
+		actual := model.View()
+
+		assert.NotContains(t, actual, "Top contributors:")
+	})
+}
+
+func rootNode() reporeader.TreeNode {
+	lastChange := time.Date(2023, time.March, 4, 10, 0, 0, 0, time.UTC)
+
+	return reporeader.TreeNode{
+		IsDir: true,
+		Files: 4,
+		Children: []reporeader.TreeNode{
+			{
+				Name:       "services",
+				Path:       "services",
+				IsDir:      true,
+				Files:      3,
+				Lines:      120,
+				Commits:    7,
+				LastChange: lastChange,
+				Contributors: []reporeader.AuthorOwnership{
+					{Email: "billing@gitcha.com", Lines: 90, Percent: 75},
+					{Email: "other@gitcha.com", Lines: 30, Percent: 25},
+				},
+				Children: []reporeader.TreeNode{
+					{
+						Name:     "billing",
+						Path:     "services/billing",
+						IsDir:    true,
+						Files:    2,
+						Children: []reporeader.TreeNode{{Name: "invoice.go", Path: "services/billing/invoice.go", Files: 1}},
+					},
+					{Name: "main.go", Path: "services/main.go", Files: 1},
+				},
+			},
+			{Name: "README.md", Path: "README.md", Files: 1},
+		},
+	}
+}